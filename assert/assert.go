@@ -27,6 +27,28 @@ func NotNil(t checkmate.TestingT, value any, msgAndArgs ...any) {
 	}
 }
 
+// Zero asserts whether the value is the zero value of its type.
+func Zero(t checkmate.TestingT, value any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Zero(t, value, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// NotZero asserts whether the value is not the zero value of its type.
+func NotZero(t checkmate.TestingT, value any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NotZero(t, value, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
 // True asserts whether the condition is true.
 func True(t checkmate.TestingT, condition bool, msgAndArgs ...any) {
 	if ht, ok := t.(helperT); ok {
@@ -96,7 +118,8 @@ func NotErrorContains(t checkmate.TestingT, err error, errText string, msgAndArg
 }
 
 // DeepEqual asserts that two values are deeply equal. If they are not equal,
-// it logs the differences.
+// it logs the differences. check.CompareOptions may be passed in msgAndArgs
+// to configure the comparison.
 func DeepEqual(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
@@ -107,7 +130,8 @@ func DeepEqual(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) {
 	}
 }
 
-// NotDeepEqual asserts if two values are not deeply equal.
+// NotDeepEqual asserts if two values are not deeply equal. check.CompareOptions
+// may be passed in msgAndArgs to configure the comparison.
 func NotDeepEqual(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
@@ -140,6 +164,51 @@ func NotEqual(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) {
 	}
 }
 
+// EqualOf is the type-safe counterpart to Equal. Both values must share the
+// same comparable type, so mismatched types are a compile error.
+func EqualOf[T comparable](t checkmate.TestingT, actual, expected T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.EqualOf(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// NotEqualOf is the type-safe counterpart to NotEqual.
+func NotEqualOf[T comparable](t checkmate.TestingT, actual, expected T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NotEqualOf(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// DeepEqualOf is the type-safe counterpart to DeepEqual.
+func DeepEqualOf[T any](t checkmate.TestingT, actual, expected T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.DeepEqualOf(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// NotDeepEqualOf is the type-safe counterpart to NotDeepEqual.
+func NotDeepEqualOf[T any](t checkmate.TestingT, actual, expected T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NotDeepEqualOf(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
 type helperT interface {
 	Helper()
 }
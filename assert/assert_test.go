@@ -3,11 +3,14 @@ package assert
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
 	"github.com/eugenetriguba/checkmate/internal/cmtest"
 )
 
@@ -110,6 +113,446 @@ func wrappedAssertNotEqual(t checkmate.TestingT, args []any) {
 	}
 }
 
+func wrappedAssertEqualOf(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		EqualOf(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		EqualOf(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedAssertNotEqualOf(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		NotEqualOf(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		NotEqualOf(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedAssertDeepEqualOf(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		DeepEqualOf(t, args[0].([]int), args[1].([]int), args[2:]...)
+	} else {
+		DeepEqualOf(t, args[0].([]int), args[1].([]int))
+	}
+}
+
+func wrappedAssertNotDeepEqualOf(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		NotDeepEqualOf(t, args[0].([]int), args[1].([]int), args[2:]...)
+	} else {
+		NotDeepEqualOf(t, args[0].([]int), args[1].([]int))
+	}
+}
+
+func wrappedAssertMatches(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		Matches(t, args[0], args[1].(check.Matcher), args[2:]...)
+	} else {
+		Matches(t, args[0], args[1].(check.Matcher))
+	}
+}
+
+func wrappedAssertLen(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		Len(t, args[0], args[1].(int), args[2:]...)
+	} else {
+		Len(t, args[0], args[1].(int))
+	}
+}
+
+func wrappedAssertEmpty(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		Empty(t, args[0], args[1:]...)
+	} else {
+		Empty(t, args[0])
+	}
+}
+
+func wrappedAssertNotEmpty(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		NotEmpty(t, args[0], args[1:]...)
+	} else {
+		NotEmpty(t, args[0])
+	}
+}
+
+func wrappedAssertContains(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		Contains(t, args[0], args[1], args[2:]...)
+	} else {
+		Contains(t, args[0], args[1])
+	}
+}
+
+func wrappedAssertNotContains(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		NotContains(t, args[0], args[1], args[2:]...)
+	} else {
+		NotContains(t, args[0], args[1])
+	}
+}
+
+func wrappedAssertElementsMatch(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		ElementsMatch(t, args[0], args[1], args[2:]...)
+	} else {
+		ElementsMatch(t, args[0], args[1])
+	}
+}
+
+func wrappedAssertSubset(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		Subset(t, args[0], args[1], args[2:]...)
+	} else {
+		Subset(t, args[0], args[1])
+	}
+}
+
+func wrappedAssertPanics(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		Panics(t, args[0].(func()), args[1:]...)
+	} else {
+		Panics(t, args[0].(func()))
+	}
+}
+
+func wrappedAssertNotPanics(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		NotPanics(t, args[0].(func()), args[1:]...)
+	} else {
+		NotPanics(t, args[0].(func()))
+	}
+}
+
+func wrappedAssertPanicsWithValue(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		PanicsWithValue(t, args[0].(func()), args[1], args[2:]...)
+	} else {
+		PanicsWithValue(t, args[0].(func()), args[1])
+	}
+}
+
+func wrappedAssertPanicsWithError(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		PanicsWithError(t, args[0].(func()), args[1].(error), args[2:]...)
+	} else {
+		PanicsWithError(t, args[0].(func()), args[1].(error))
+	}
+}
+
+func wrappedAssertPanicsMatching(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		PanicsMatching(t, args[0].(func()), args[1].(string), args[2:]...)
+	} else {
+		PanicsMatching(t, args[0].(func()), args[1].(string))
+	}
+}
+
+func wrappedAssertEventually(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		Eventually(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration), args[3:]...)
+	} else {
+		Eventually(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration))
+	}
+}
+
+func wrappedAssertConsistently(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		Consistently(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration), args[3:]...)
+	} else {
+		Consistently(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration))
+	}
+}
+
+func wrappedAssertNever(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		Never(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration), args[3:]...)
+	} else {
+		Never(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration))
+	}
+}
+
+func wrappedAssertEventuallyWithT(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		EventuallyWithT(t, args[0].(func(checkmate.TestingT)), args[1].(time.Duration), args[2].(time.Duration), args[3:]...)
+	} else {
+		EventuallyWithT(t, args[0].(func(checkmate.TestingT)), args[1].(time.Duration), args[2].(time.Duration))
+	}
+}
+
+func wrappedAssertErrorAs(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		ErrorAs[*fs.PathError](t, args[0].(error), args[1:]...)
+	} else {
+		ErrorAs[*fs.PathError](t, args[0].(error))
+	}
+}
+
+func wrappedAssertNoError(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		NoError(t, errorOrNil(args[0]), args[1:]...)
+	} else {
+		NoError(t, errorOrNil(args[0]))
+	}
+}
+
+func wrappedAssertError(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		Error(t, errorOrNil(args[0]), args[1:]...)
+	} else {
+		Error(t, errorOrNil(args[0]))
+	}
+}
+
+func wrappedAssertErrorEqualsString(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		ErrorEqualsString(t, errorOrNil(args[0]), args[1].(string), args[2:]...)
+	} else {
+		ErrorEqualsString(t, errorOrNil(args[0]), args[1].(string))
+	}
+}
+
+func wrappedAssertErrorMatches(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		ErrorMatches(t, errorOrNil(args[0]), args[1].(string), args[2:]...)
+	} else {
+		ErrorMatches(t, errorOrNil(args[0]), args[1].(string))
+	}
+}
+
+func wrappedAssertZero(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		Zero(t, args[0], args[1:]...)
+	} else {
+		Zero(t, args[0])
+	}
+}
+
+func wrappedAssertNotZero(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		NotZero(t, args[0], args[1:]...)
+	} else {
+		NotZero(t, args[0])
+	}
+}
+
+func wrappedAssertInDelta(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		InDelta(t, args[0].(float64), args[1].(float64), args[2].(float64), args[3:]...)
+	} else {
+		InDelta(t, args[0].(float64), args[1].(float64), args[2].(float64))
+	}
+}
+
+func wrappedAssertInEpsilon(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		InEpsilon(t, args[0].(float64), args[1].(float64), args[2].(float64), args[3:]...)
+	} else {
+		InEpsilon(t, args[0].(float64), args[1].(float64), args[2].(float64))
+	}
+}
+
+func wrappedAssertInULPs(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		InULPs(t, args[0].(float64), args[1].(float64), args[2].(uint64), args[3:]...)
+	} else {
+		InULPs(t, args[0].(float64), args[1].(float64), args[2].(uint64))
+	}
+}
+
+func wrappedAssertInDeltaSlice(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		InDeltaSlice(t, args[0].([]float64), args[1].([]float64), args[2].(float64), args[3:]...)
+	} else {
+		InDeltaSlice(t, args[0].([]float64), args[1].([]float64), args[2].(float64))
+	}
+}
+
+func wrappedAssertInDeltaMapValues(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		InDeltaMapValues(t, args[0].(map[string]float64), args[1].(map[string]float64), args[2].(float64), args[3:]...)
+	} else {
+		InDeltaMapValues(t, args[0].(map[string]float64), args[1].(map[string]float64), args[2].(float64))
+	}
+}
+
+func wrappedAssertGreater(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		Greater(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		Greater(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedAssertGreaterOrEqual(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		GreaterOrEqual(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		GreaterOrEqual(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedAssertLess(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		Less(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		Less(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedAssertLessOrEqual(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		LessOrEqual(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		LessOrEqual(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedAssertBetween(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		Between(t, args[0].(int), args[1].(int), args[2].(int), args[3:]...)
+	} else {
+		Between(t, args[0].(int), args[1].(int), args[2].(int))
+	}
+}
+
+func wrappedAssertPositive(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		Positive(t, args[0].(int), args[1:]...)
+	} else {
+		Positive(t, args[0].(int))
+	}
+}
+
+func wrappedAssertNegative(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		Negative(t, args[0].(int), args[1:]...)
+	} else {
+		Negative(t, args[0].(int))
+	}
+}
+
+func wrappedAssertIsSorted(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		IsSorted(t, args[0].([]int), args[1:]...)
+	} else {
+		IsSorted(t, args[0].([]int))
+	}
+}
+
+func wrappedAssertIsStrictlyIncreasing(t checkmate.TestingT, args []any) {
+	if len(args) > 1 {
+		IsStrictlyIncreasing(t, args[0].([]int), args[1:]...)
+	} else {
+		IsStrictlyIncreasing(t, args[0].([]int))
+	}
+}
+
+func wrappedAssertHasPrefix(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		HasPrefix(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		HasPrefix(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedAssertHasSuffix(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		HasSuffix(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		HasSuffix(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedAssertContainsString(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		ContainsString(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		ContainsString(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedAssertMatchesRegexp(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		MatchesRegexp(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		MatchesRegexp(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedAssertEqualFold(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		EqualFold(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		EqualFold(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedAssertJSONEq(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		JSONEq(t, args[0], args[1], args[2:]...)
+	} else {
+		JSONEq(t, args[0], args[1])
+	}
+}
+
+func wrappedAssertJSONPath(t checkmate.TestingT, args []any) {
+	if len(args) > 3 {
+		JSONPath(t, args[0], args[1].(string), args[2], args[3:]...)
+	} else {
+		JSONPath(t, args[0], args[1].(string), args[2])
+	}
+}
+
+func wrappedAssertJSONContains(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		JSONContains(t, args[0], args[1], args[2:]...)
+	} else {
+		JSONContains(t, args[0], args[1])
+	}
+}
+
+func wrappedAssertYAMLEq(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		YAMLEq(t, args[0], args[1], args[2:]...)
+	} else {
+		YAMLEq(t, args[0], args[1])
+	}
+}
+
+func wrappedAssertXMLEq(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		XMLEq(t, args[0], args[1], args[2:]...)
+	} else {
+		XMLEq(t, args[0], args[1])
+	}
+}
+
+func wrappedAssertNotReceives(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		NotReceives(t, args[0].(chan int), args[1].(time.Duration), args[2:]...)
+	} else {
+		NotReceives(t, args[0].(chan int), args[1].(time.Duration))
+	}
+}
+
+func wrappedAssertClosed(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		Closed(t, args[0].(chan int), args[1].(time.Duration), args[2:]...)
+	} else {
+		Closed(t, args[0].(chan int), args[1].(time.Duration))
+	}
+}
+
+func wrappedAssertGroup(t checkmate.TestingT, args []any) {
+	if len(args) > 2 {
+		Group(t, args[0].(string), args[1].(func(checkmate.TestingT)), args[2:]...)
+	} else {
+		Group(t, args[0].(string), args[1].(func(checkmate.TestingT)))
+	}
+}
+
 var passingTestFns = []struct {
 	name        string
 	assertionFn assertFn
@@ -127,6 +570,60 @@ var passingTestFns = []struct {
 	{"AssertNotDeepEqual", wrappedAssertNotDeepEqual, []any{5, 6}},
 	{"AssertEqual", wrappedAssertEqual, []any{5, 5}},
 	{"AssertNotEqual", wrappedAssertNotEqual, []any{5, 10}},
+	{"AssertEqualOf", wrappedAssertEqualOf, []any{5, 5}},
+	{"AssertNotEqualOf", wrappedAssertNotEqualOf, []any{5, 10}},
+	{"AssertDeepEqualOf", wrappedAssertDeepEqualOf, []any{[]int{1, 2}, []int{1, 2}}},
+	{"AssertNotDeepEqualOf", wrappedAssertNotDeepEqualOf, []any{[]int{1, 2}, []int{2, 1}}},
+	{"AssertMatches", wrappedAssertMatches, []any{5, check.EqualTo(5)}},
+	{"AssertLen", wrappedAssertLen, []any{[]int{1, 2}, 2}},
+	{"AssertEmpty", wrappedAssertEmpty, []any{[]int{}}},
+	{"AssertNotEmpty", wrappedAssertNotEmpty, []any{"a"}},
+	{"AssertContains", wrappedAssertContains, []any{[]int{1, 2}, 2}},
+	{"AssertNotContains", wrappedAssertNotContains, []any{[]int{1, 2}, 3}},
+	{"AssertElementsMatch", wrappedAssertElementsMatch, []any{[]int{1, 2}, []int{2, 1}}},
+	{"AssertSubset", wrappedAssertSubset, []any{[]int{1, 2, 3}, []int{3, 1}}},
+	{"AssertPanics", wrappedAssertPanics, []any{func() { panic("boom") }}},
+	{"AssertNotPanics", wrappedAssertNotPanics, []any{func() {}}},
+	{"AssertPanicsWithValue", wrappedAssertPanicsWithValue, []any{func() { panic("boom") }, "boom"}},
+	{"AssertPanicsWithError", wrappedAssertPanicsWithError, []any{func() { panic(os.ErrClosed) }, os.ErrClosed}},
+	{"AssertPanicsMatching", wrappedAssertPanicsMatching, []any{func() { panic("boom 42") }, `boom \d+`}},
+	{"AssertEventually", wrappedAssertEventually, []any{func() bool { return true }, 10 * time.Millisecond, time.Millisecond}},
+	{"AssertConsistently", wrappedAssertConsistently, []any{func() bool { return true }, 10 * time.Millisecond, time.Millisecond}},
+	{"AssertNever", wrappedAssertNever, []any{func() bool { return false }, 10 * time.Millisecond, time.Millisecond}},
+	{"AssertEventuallyWithT", wrappedAssertEventuallyWithT, []any{func(t checkmate.TestingT) {}, 10 * time.Millisecond, time.Millisecond}},
+	{"AssertErrorAs", wrappedAssertErrorAs, []any{&fs.PathError{Op: "open", Err: os.ErrNotExist}}},
+	{"AssertNoError", wrappedAssertNoError, []any{nil}},
+	{"AssertError", wrappedAssertError, []any{os.ErrClosed}},
+	{"AssertErrorEqualsString", wrappedAssertErrorEqualsString, []any{errors.New("boom"), "boom"}},
+	{"AssertErrorMatches", wrappedAssertErrorMatches, []any{errors.New("code 42"), `code \d+`}},
+	{"AssertZero", wrappedAssertZero, []any{""}},
+	{"AssertNotZero", wrappedAssertNotZero, []any{5}},
+	{"AssertInDelta", wrappedAssertInDelta, []any{1.0, 1.05, 0.1}},
+	{"AssertInEpsilon", wrappedAssertInEpsilon, []any{101.0, 100.0, 0.02}},
+	{"AssertInULPs", wrappedAssertInULPs, []any{0.1 + 0.2, 0.3, uint64(1)}},
+	{"AssertInDeltaSlice", wrappedAssertInDeltaSlice, []any{[]float64{1, 2}, []float64{1.01, 2}, 0.1}},
+	{"AssertInDeltaMapValues", wrappedAssertInDeltaMapValues, []any{map[string]float64{"a": 1}, map[string]float64{"a": 1.01}, 0.1}},
+	{"AssertGreater", wrappedAssertGreater, []any{2, 1}},
+	{"AssertGreaterOrEqual", wrappedAssertGreaterOrEqual, []any{1, 1}},
+	{"AssertLess", wrappedAssertLess, []any{1, 2}},
+	{"AssertLessOrEqual", wrappedAssertLessOrEqual, []any{1, 1}},
+	{"AssertBetween", wrappedAssertBetween, []any{2, 1, 3}},
+	{"AssertPositive", wrappedAssertPositive, []any{1}},
+	{"AssertNegative", wrappedAssertNegative, []any{-1}},
+	{"AssertIsSorted", wrappedAssertIsSorted, []any{[]int{1, 1, 2}}},
+	{"AssertIsStrictlyIncreasing", wrappedAssertIsStrictlyIncreasing, []any{[]int{1, 2}}},
+	{"AssertHasPrefix", wrappedAssertHasPrefix, []any{"checkmate", "check"}},
+	{"AssertHasSuffix", wrappedAssertHasSuffix, []any{"checkmate", "mate"}},
+	{"AssertContainsString", wrappedAssertContainsString, []any{"checkmate", "km"}},
+	{"AssertMatchesRegexp", wrappedAssertMatchesRegexp, []any{"v1.2.3", `^v\d+\.\d+\.\d+$`}},
+	{"AssertEqualFold", wrappedAssertEqualFold, []any{"Go", "GO"}},
+	{"AssertJSONEq", wrappedAssertJSONEq, []any{`{"a": [1, 2]}`, `{"a":[1,2]}`}},
+	{"AssertJSONPath", wrappedAssertJSONPath, []any{`{"a": [1, 2]}`, "$.a[1]", 2}},
+	{"AssertJSONContains", wrappedAssertJSONContains, []any{`{"a": 1, "b": 2}`, `{"b": 2}`}},
+	{"AssertYAMLEq", wrappedAssertYAMLEq, []any{"a: [1, 2]", "a:\n  - 1\n  - 2"}},
+	{"AssertXMLEq", wrappedAssertXMLEq, []any{`<a x="1" y="2"/>`, `<a y="2" x="1"></a>`}},
+	{"AssertNotReceives", wrappedAssertNotReceives, []any{(chan int)(nil), time.Millisecond}},
+	{"AssertGroup", wrappedAssertGroup, []any{"group", func(t checkmate.TestingT) {}}},
 }
 
 var failingTestFns = []struct {
@@ -146,6 +643,61 @@ var failingTestFns = []struct {
 	{"AssertNotDeepEqual", wrappedAssertNotDeepEqual, []any{5, 5}},
 	{"AssertEqual", wrappedAssertEqual, []any{5, 10}},
 	{"AssertNotEqual", wrappedAssertNotEqual, []any{5, 5}},
+	{"AssertEqualOf", wrappedAssertEqualOf, []any{5, 10}},
+	{"AssertNotEqualOf", wrappedAssertNotEqualOf, []any{5, 5}},
+	{"AssertDeepEqualOf", wrappedAssertDeepEqualOf, []any{[]int{1, 2}, []int{2, 1}}},
+	{"AssertNotDeepEqualOf", wrappedAssertNotDeepEqualOf, []any{[]int{1, 2}, []int{1, 2}}},
+	{"AssertMatches", wrappedAssertMatches, []any{5, check.EqualTo(10)}},
+	{"AssertLen", wrappedAssertLen, []any{[]int{1, 2}, 3}},
+	{"AssertEmpty", wrappedAssertEmpty, []any{[]int{1}}},
+	{"AssertNotEmpty", wrappedAssertNotEmpty, []any{""}},
+	{"AssertContains", wrappedAssertContains, []any{[]int{1, 2}, 3}},
+	{"AssertNotContains", wrappedAssertNotContains, []any{[]int{1, 2}, 2}},
+	{"AssertElementsMatch", wrappedAssertElementsMatch, []any{[]int{1, 2}, []int{1, 3}}},
+	{"AssertSubset", wrappedAssertSubset, []any{[]int{1, 2}, []int{3}}},
+	{"AssertPanics", wrappedAssertPanics, []any{func() {}}},
+	{"AssertNotPanics", wrappedAssertNotPanics, []any{func() { panic("boom") }}},
+	{"AssertPanicsWithValue", wrappedAssertPanicsWithValue, []any{func() { panic("boom") }, "bang"}},
+	{"AssertPanicsWithError", wrappedAssertPanicsWithError, []any{func() { panic(os.ErrClosed) }, os.ErrExist}},
+	{"AssertPanicsMatching", wrappedAssertPanicsMatching, []any{func() { panic("boom") }, `bang`}},
+	{"AssertEventually", wrappedAssertEventually, []any{func() bool { return false }, 10 * time.Millisecond, time.Millisecond}},
+	{"AssertEventuallyZeroInterval", wrappedAssertEventually, []any{func() bool { return true }, 10 * time.Millisecond, time.Duration(0)}},
+	{"AssertConsistently", wrappedAssertConsistently, []any{func() bool { return false }, 10 * time.Millisecond, time.Millisecond}},
+	{"AssertNever", wrappedAssertNever, []any{func() bool { return true }, 10 * time.Millisecond, time.Millisecond}},
+	{"AssertEventuallyWithT", wrappedAssertEventuallyWithT, []any{func(t checkmate.TestingT) { t.Fail() }, 10 * time.Millisecond, time.Millisecond}},
+	{"AssertErrorAs", wrappedAssertErrorAs, []any{os.ErrClosed}},
+	{"AssertNoError", wrappedAssertNoError, []any{os.ErrClosed}},
+	{"AssertError", wrappedAssertError, []any{nil}},
+	{"AssertErrorEqualsString", wrappedAssertErrorEqualsString, []any{errors.New("boom"), "bang"}},
+	{"AssertErrorMatches", wrappedAssertErrorMatches, []any{errors.New("code x"), `code \d+`}},
+	{"AssertZero", wrappedAssertZero, []any{"a"}},
+	{"AssertNotZero", wrappedAssertNotZero, []any{0}},
+	{"AssertInDelta", wrappedAssertInDelta, []any{1.0, 1.5, 0.1}},
+	{"AssertInEpsilon", wrappedAssertInEpsilon, []any{110.0, 100.0, 0.02}},
+	{"AssertInULPs", wrappedAssertInULPs, []any{1.0, 1.1, uint64(1)}},
+	{"AssertInDeltaSlice", wrappedAssertInDeltaSlice, []any{[]float64{1, 2}, []float64{1, 3}, 0.1}},
+	{"AssertInDeltaMapValues", wrappedAssertInDeltaMapValues, []any{map[string]float64{"a": 1}, map[string]float64{"a": 2}, 0.1}},
+	{"AssertGreater", wrappedAssertGreater, []any{1, 1}},
+	{"AssertGreaterOrEqual", wrappedAssertGreaterOrEqual, []any{0, 1}},
+	{"AssertLess", wrappedAssertLess, []any{1, 1}},
+	{"AssertLessOrEqual", wrappedAssertLessOrEqual, []any{2, 1}},
+	{"AssertBetween", wrappedAssertBetween, []any{4, 1, 3}},
+	{"AssertPositive", wrappedAssertPositive, []any{0}},
+	{"AssertNegative", wrappedAssertNegative, []any{0}},
+	{"AssertIsSorted", wrappedAssertIsSorted, []any{[]int{2, 1}}},
+	{"AssertIsStrictlyIncreasing", wrappedAssertIsStrictlyIncreasing, []any{[]int{1, 1}}},
+	{"AssertHasPrefix", wrappedAssertHasPrefix, []any{"checkmate", "mate"}},
+	{"AssertHasSuffix", wrappedAssertHasSuffix, []any{"checkmate", "check"}},
+	{"AssertContainsString", wrappedAssertContainsString, []any{"checkmate", "xyz"}},
+	{"AssertMatchesRegexp", wrappedAssertMatchesRegexp, []any{"v1.2", `^v\d+\.\d+\.\d+$`}},
+	{"AssertEqualFold", wrappedAssertEqualFold, []any{"Go", "Rust"}},
+	{"AssertJSONEq", wrappedAssertJSONEq, []any{`{"a": 1}`, `{"a": 2}`}},
+	{"AssertJSONPath", wrappedAssertJSONPath, []any{`{"a": [1, 2]}`, "$.a[2]", 2}},
+	{"AssertJSONContains", wrappedAssertJSONContains, []any{`{"a": 1}`, `{"b": 2}`}},
+	{"AssertYAMLEq", wrappedAssertYAMLEq, []any{"a: 1", "a: 2"}},
+	{"AssertXMLEq", wrappedAssertXMLEq, []any{`<a>1</a>`, `<a>2</a>`}},
+	{"AssertClosed", wrappedAssertClosed, []any{(chan int)(nil), time.Millisecond}},
+	{"AssertGroup", wrappedAssertGroup, []any{"group", func(t checkmate.TestingT) { t.Fail() }}},
 }
 
 func TestOptionalMessageAndArgs(t *testing.T) {
@@ -245,7 +797,12 @@ func TestAssertEqual(t *testing.T) {
 		{"EqualFloats", 5.123, 5.123, false, []string{}},
 		{"UnequalFloats", 5.123, 5.1234, true, []string{"expected 5.123 to equal 5.1234"}},
 		{"EqualStrings", "test", "test", false, []string{}},
-		{"UnequalStrings", "test", "fail", true, []string{"expected test to equal fail"}},
+		{"UnequalStrings", "test", "tent", true, []string{
+			"strings differ:\n" +
+				"  actual:   \"test\"\n" +
+				"  expected: \"tent\"\n" +
+				"               ^ first difference at rune 2",
+		}},
 		{"EqualBooleans", true, true, false, []string{}},
 		{"UnequalBooleans", false, true, true, []string{"expected false to equal true"}},
 	}
@@ -322,3 +879,9 @@ func TestAssertErrorIsChecksErrTree(t *testing.T) {
 		t.Fatal("AssertErrorIs failed when it should have passed")
 	}
 }
+
+// errorOrNil converts a table argument to an error, allowing untyped nil.
+func errorOrNil(arg any) error {
+	err, _ := arg.(error)
+	return err
+}
@@ -0,0 +1,97 @@
+package assert
+
+import (
+	"time"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Receives asserts whether a value is received from ch within timeout, and
+// returns it.
+func Receives[T any](t checkmate.TestingT, ch <-chan T, timeout time.Duration, msgAndArgs ...any) T {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	value, passed := check.Receives(t, ch, timeout, msgAndArgs...)
+	if !passed {
+		t.FailNow()
+	}
+	return value
+}
+
+// ReceivesValue asserts whether a value deeply equal to expected is
+// received from ch within timeout.
+func ReceivesValue[T any](t checkmate.TestingT, ch <-chan T, expected T, timeout time.Duration, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.ReceivesValue(t, ch, expected, timeout, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// NotReceives asserts whether nothing is received from ch for the whole
+// duration.
+func NotReceives[T any](t checkmate.TestingT, ch <-chan T, duration time.Duration, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NotReceives(t, ch, duration, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Closed asserts whether ch is closed within timeout.
+func Closed[T any](t checkmate.TestingT, ch <-chan T, timeout time.Duration, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Closed(t, ch, timeout, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Drains asserts whether ch is closed within timeout, and returns the values
+// received before that.
+func Drains[T any](t checkmate.TestingT, ch <-chan T, timeout time.Duration, msgAndArgs ...any) []T {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	values, passed := check.Drains(t, ch, timeout, msgAndArgs...)
+	if !passed {
+		t.FailNow()
+	}
+	return values
+}
+
+// ReceivesAll asserts whether the values received from ch within timeout
+// deeply equal expected, in order.
+func ReceivesAll[T any](t checkmate.TestingT, ch <-chan T, expected []T, timeout time.Duration, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.ReceivesAll(t, ch, expected, timeout, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// ReceivesAllInAnyOrder asserts whether the values received from ch within
+// timeout match expected in any order.
+func ReceivesAllInAnyOrder[T any](
+	t checkmate.TestingT, ch <-chan T, expected []T, timeout time.Duration, msgAndArgs ...any,
+) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.ReceivesAllInAnyOrder(t, ch, expected, timeout, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
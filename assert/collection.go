@@ -0,0 +1,84 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Len asserts whether the value has the given length.
+func Len(t checkmate.TestingT, value any, length int, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Len(t, value, length, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Empty asserts whether the value is nil or has a length of zero.
+func Empty(t checkmate.TestingT, value any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Empty(t, value, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// NotEmpty asserts whether the value has a length greater than zero.
+func NotEmpty(t checkmate.TestingT, value any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NotEmpty(t, value, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Contains asserts whether the container holds the element.
+func Contains(t checkmate.TestingT, container, element any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Contains(t, container, element, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// NotContains asserts whether the container does not hold the element.
+func NotContains(t checkmate.TestingT, container, element any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NotContains(t, container, element, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// ElementsMatch asserts whether two slices or arrays hold the same elements,
+// ignoring order.
+func ElementsMatch(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.ElementsMatch(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Subset asserts whether every element of subset is present in list.
+func Subset(t checkmate.TestingT, list, subset any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Subset(t, list, subset, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,68 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// ErrorAs asserts whether err's error tree contains an error assignable to
+// T, as reported by errors.As, and returns the matched error.
+//
+//	pathErr := assert.ErrorAs[*fs.PathError](t, err)
+//	assert.Equal(t, pathErr.Op, "open")
+func ErrorAs[T error](t checkmate.TestingT, err error, msgAndArgs ...any) T {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	target, passed := check.ErrorAs[T](t, err, msgAndArgs...)
+	if !passed {
+		t.FailNow()
+	}
+	return target
+}
+
+// NoError asserts whether err is nil.
+func NoError(t checkmate.TestingT, err error, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NoError(t, err, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Error asserts whether err is not nil.
+func Error(t checkmate.TestingT, err error, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Error(t, err, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// ErrorEqualsString asserts whether err.Error() is exactly errText.
+func ErrorEqualsString(t checkmate.TestingT, err error, errText string, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.ErrorEqualsString(t, err, errText, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// ErrorMatches asserts whether err.Error() matches the regular expression
+// pattern.
+func ErrorMatches(t checkmate.TestingT, err error, pattern string, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.ErrorMatches(t, err, pattern, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
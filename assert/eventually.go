@@ -0,0 +1,120 @@
+package assert
+
+import (
+	"context"
+	"time"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Eventually asserts whether condition returns true within timeout.
+func Eventually(t checkmate.TestingT, condition func() bool, timeout, interval time.Duration, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Eventually(t, condition, timeout, interval, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// EventuallyContext is like Eventually, but also stops polling and fails
+// once ctx is done.
+func EventuallyContext(
+	ctx context.Context, t checkmate.TestingT, condition func() bool,
+	timeout, interval time.Duration, msgAndArgs ...any,
+) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.EventuallyContext(ctx, t, condition, timeout, interval, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Consistently asserts whether condition keeps returning true for the whole
+// duration.
+func Consistently(t checkmate.TestingT, condition func() bool, duration, interval time.Duration, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Consistently(t, condition, duration, interval, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// ConsistentlyContext is like Consistently, but fails if ctx is done before
+// the duration has elapsed.
+func ConsistentlyContext(
+	ctx context.Context, t checkmate.TestingT, condition func() bool,
+	duration, interval time.Duration, msgAndArgs ...any,
+) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.ConsistentlyContext(ctx, t, condition, duration, interval, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Never asserts whether condition keeps returning false for the whole
+// duration.
+func Never(t checkmate.TestingT, condition func() bool, duration, interval time.Duration, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Never(t, condition, duration, interval, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// NeverContext is like Never, but fails if ctx is done before the duration
+// has elapsed.
+func NeverContext(
+	ctx context.Context, t checkmate.TestingT, condition func() bool,
+	duration, interval time.Duration, msgAndArgs ...any,
+) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NeverContext(ctx, t, condition, duration, interval, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// EventuallyWithT asserts whether fn completes without failing within
+// timeout. Only the test is stopped on failure; assert failures inside fn
+// end the current attempt.
+func EventuallyWithT(
+	t checkmate.TestingT, fn func(t checkmate.TestingT),
+	timeout, interval time.Duration, msgAndArgs ...any,
+) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.EventuallyWithT(t, fn, timeout, interval, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// EventuallyWithTContext is like EventuallyWithT, but also stops polling and
+// fails once ctx is done.
+func EventuallyWithTContext(
+	ctx context.Context, t checkmate.TestingT, fn func(t checkmate.TestingT),
+	timeout, interval time.Duration, msgAndArgs ...any,
+) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.EventuallyWithTContext(ctx, t, fn, timeout, interval, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
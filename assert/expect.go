@@ -0,0 +1,127 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Expectation is the assert counterpart of check.Expectation. Terminal
+// methods fail the test immediately when the expectation does not hold.
+type Expectation struct {
+	t     checkmate.TestingT
+	check *check.Expectation
+}
+
+// That starts an expectation on value.
+//
+//	assert.That(t, got).ToEqual(5)
+//	assert.That(t, err).Not().ToBeNil()
+func That(t checkmate.TestingT, value any) *Expectation {
+	return &Expectation{t: t, check: check.That(t, value)}
+}
+
+// Not returns a copy of the expectation whose terminal method is negated.
+func (e *Expectation) Not() *Expectation {
+	return &Expectation{t: e.t, check: e.check.Not()}
+}
+
+// ToEqual asserts the value against expected with Equal, or NotEqual when negated.
+func (e *Expectation) ToEqual(expected any, msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToEqual(expected, msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
+
+// ToDeepEqual asserts the value against expected with DeepEqual, or
+// NotDeepEqual when negated.
+func (e *Expectation) ToDeepEqual(expected any, msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToDeepEqual(expected, msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
+
+// ToBeNil asserts the value with Nil, or NotNil when negated.
+func (e *Expectation) ToBeNil(msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToBeNil(msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
+
+// ToBeTrue asserts the value with True, or False when negated.
+func (e *Expectation) ToBeTrue(msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToBeTrue(msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
+
+// ToBeFalse asserts the value with False, or True when negated.
+func (e *Expectation) ToBeFalse(msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToBeFalse(msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
+
+// ToMatchError asserts the value with ErrorIs, or NotErrorIs when negated.
+func (e *Expectation) ToMatchError(target error, msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToMatchError(target, msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
+
+// ToContain asserts whether the value contains element.
+func (e *Expectation) ToContain(element any, msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToContain(element, msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
+
+// ToBeEmpty asserts the value with Empty, or NotEmpty when negated.
+func (e *Expectation) ToBeEmpty(msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToBeEmpty(msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
+
+// ToHaveLen asserts the value with Len, or that it has any other length
+// when negated.
+func (e *Expectation) ToHaveLen(length int, msgAndArgs ...any) {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := e.check.ToHaveLen(length, msgAndArgs...); !passed {
+		e.t.FailNow()
+	}
+}
@@ -0,0 +1,40 @@
+package assert
+
+import (
+	"os"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestExpectationFailsNow(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT)
+		shouldFail bool
+	}{
+		{"ToEqual passing", func(t checkmate.TestingT) { That(t, 5).ToEqual(5) }, false},
+		{"ToEqual failing", func(t checkmate.TestingT) { That(t, 5).ToEqual(10) }, true},
+		{"Not ToEqual failing", func(t checkmate.TestingT) { That(t, 5).Not().ToEqual(5) }, true},
+		{"ToDeepEqual failing", func(t checkmate.TestingT) { That(t, []int{1}).ToDeepEqual([]int{2}) }, true},
+		{"ToBeNil failing", func(t checkmate.TestingT) { That(t, 1).ToBeNil() }, true},
+		{"ToBeTrue failing", func(t checkmate.TestingT) { That(t, false).ToBeTrue() }, true},
+		{"ToBeFalse failing", func(t checkmate.TestingT) { That(t, true).ToBeFalse() }, true},
+		{"ToMatchError failing", func(t checkmate.TestingT) { That(t, os.ErrClosed).ToMatchError(os.ErrExist) }, true},
+		{"ToContain failing", func(t checkmate.TestingT) { That(t, []int{1}).ToContain(2) }, true},
+		{"Not ToContain passing", func(t checkmate.TestingT) { That(t, []int{1}).Not().ToContain(2) }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			tc.fn(mockT)
+
+			if mockT.FailNowCalled != tc.shouldFail {
+				t.Errorf("%s: FailNowCalled = %v, want %v", tc.name, mockT.FailNowCalled, tc.shouldFail)
+			}
+		})
+	}
+}
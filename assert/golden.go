@@ -0,0 +1,19 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Golden asserts whether actual matches the golden file
+// testdata/<TestName>/<name>.golden. See check.Golden for the update flag
+// and the accepted options.
+func Golden(t checkmate.TestingT, name string, actual []byte, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Golden(t, name, actual, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
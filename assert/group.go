@@ -0,0 +1,19 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Group runs fn with its own checkmate.TestingT and reports every failure
+// inside it as one numbered report, stopping the test afterwards if any of
+// them failed. See check.Group.
+func Group(t checkmate.TestingT, name string, fn func(t checkmate.TestingT), msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Group(t, name, fn, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,67 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// JSONEq asserts whether actual and expected are equivalent JSON documents,
+// regardless of object key order and whitespace. Each document may be a
+// []byte, a string, or an io.Reader.
+func JSONEq(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.JSONEq(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// JSONPath asserts whether the value at path in the JSON document doc equals
+// expected. See check.JSONPath for the supported path syntax.
+func JSONPath(t checkmate.TestingT, doc any, path string, expected any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.JSONPath(t, doc, path, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// JSONContains asserts whether the JSON document partial is structurally
+// present in doc.
+func JSONContains(t checkmate.TestingT, doc, partial any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.JSONContains(t, doc, partial, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// YAMLEq asserts whether actual and expected are equivalent YAML documents,
+// regardless of mapping key order, formatting, comments, and anchors.
+func YAMLEq(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.YAMLEq(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// XMLEq asserts whether actual and expected are equivalent XML documents.
+// See check.XMLEq for how elements, attributes, and text are compared.
+func XMLEq(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.XMLEq(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
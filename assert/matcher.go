@@ -0,0 +1,17 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Matches asserts whether the actual value satisfies the matcher.
+func Matches(t checkmate.TestingT, actual any, m check.Matcher, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Matches(t, actual, m, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
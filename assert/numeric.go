@@ -0,0 +1,67 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// InDelta asserts whether actual is within delta of expected.
+func InDelta[T check.Number](t checkmate.TestingT, actual, expected, delta T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.InDelta(t, actual, expected, delta, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// InEpsilon asserts whether the relative error between actual and expected
+// is at most epsilon.
+func InEpsilon[T check.Number](t checkmate.TestingT, actual, expected T, epsilon float64, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.InEpsilon(t, actual, expected, epsilon, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// InULPs asserts whether actual is at most maxULPs representable floating
+// point values away from expected.
+func InULPs[T check.Float](t checkmate.TestingT, actual, expected T, maxULPs uint64, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.InULPs(t, actual, expected, maxULPs, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// InDeltaSlice asserts whether every element of actual is within delta of
+// the element of expected at the same index.
+func InDeltaSlice[T check.Number](t checkmate.TestingT, actual, expected []T, delta T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.InDeltaSlice(t, actual, expected, delta, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// InDeltaMapValues asserts whether both maps have the same keys and every
+// value of actual is within delta of the value of expected.
+func InDeltaMapValues[K comparable, V check.Number](
+	t checkmate.TestingT, actual, expected map[K]V, delta V, msgAndArgs ...any,
+) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.InDeltaMapValues(t, actual, expected, delta, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
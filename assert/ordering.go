@@ -0,0 +1,120 @@
+package assert
+
+import (
+	"cmp"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Greater asserts whether actual is strictly greater than bound.
+func Greater[T cmp.Ordered](t checkmate.TestingT, actual, bound T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Greater(t, actual, bound, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// GreaterOrEqual asserts whether actual is greater than or equal to bound.
+func GreaterOrEqual[T cmp.Ordered](t checkmate.TestingT, actual, bound T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.GreaterOrEqual(t, actual, bound, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Less asserts whether actual is strictly less than bound.
+func Less[T cmp.Ordered](t checkmate.TestingT, actual, bound T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Less(t, actual, bound, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// LessOrEqual asserts whether actual is less than or equal to bound.
+func LessOrEqual[T cmp.Ordered](t checkmate.TestingT, actual, bound T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.LessOrEqual(t, actual, bound, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Between asserts whether actual lies within the inclusive range [low, high].
+func Between[T cmp.Ordered](t checkmate.TestingT, actual, low, high T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Between(t, actual, low, high, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Positive asserts whether actual is strictly greater than zero.
+func Positive[T check.Number](t checkmate.TestingT, actual T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Positive(t, actual, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// Negative asserts whether actual is strictly less than zero.
+func Negative[T check.Number](t checkmate.TestingT, actual T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Negative(t, actual, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// IsSorted asserts whether the slice is sorted in ascending order.
+func IsSorted[T cmp.Ordered](t checkmate.TestingT, values []T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.IsSorted(t, values, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// IsSortedFunc asserts whether the slice is sorted in ascending order as
+// defined by compare.
+func IsSortedFunc[T any](t checkmate.TestingT, values []T, compare func(a, b T) int, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.IsSortedFunc(t, values, compare, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// IsStrictlyIncreasing asserts whether every element of the slice is
+// strictly greater than the one before it.
+func IsStrictlyIncreasing[T cmp.Ordered](t checkmate.TestingT, values []T, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.IsStrictlyIncreasing(t, values, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
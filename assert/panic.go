@@ -0,0 +1,64 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Panics asserts whether calling fn panics.
+func Panics(t checkmate.TestingT, fn func(), msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.Panics(t, fn, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// NotPanics asserts whether calling fn returns without panicking.
+func NotPanics(t checkmate.TestingT, fn func(), msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.NotPanics(t, fn, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// PanicsWithValue asserts whether calling fn panics with a value that is
+// deeply equal to expected.
+func PanicsWithValue(t checkmate.TestingT, fn func(), expected any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.PanicsWithValue(t, fn, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// PanicsWithError asserts whether calling fn panics with an error that has
+// target within its error tree.
+func PanicsWithError(t checkmate.TestingT, fn func(), target error, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.PanicsWithError(t, fn, target, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// PanicsMatching asserts whether calling fn panics with a value whose
+// formatted text matches the regular expression pattern.
+func PanicsMatching(t checkmate.TestingT, fn func(), pattern string, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.PanicsMatching(t, fn, pattern, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,19 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// MatchSnapshot asserts whether value matches the snapshot stored for it in
+// testdata/__snapshots__. See check.MatchSnapshot for how snapshots are
+// written and updated.
+func MatchSnapshot(t checkmate.TestingT, value any, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.MatchSnapshot(t, value, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,62 @@
+package assert
+
+import (
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// HasPrefix asserts whether s begins with prefix.
+func HasPrefix(t checkmate.TestingT, s, prefix string, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.HasPrefix(t, s, prefix, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// HasSuffix asserts whether s ends with suffix.
+func HasSuffix(t checkmate.TestingT, s, suffix string, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.HasSuffix(t, s, suffix, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// ContainsString asserts whether s contains substr.
+func ContainsString(t checkmate.TestingT, s, substr string, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.ContainsString(t, s, substr, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// MatchesRegexp asserts whether s matches the regular expression pattern.
+func MatchesRegexp(t checkmate.TestingT, s, pattern string, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.MatchesRegexp(t, s, pattern, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
+
+// EqualFold asserts whether actual and expected are equal under simple
+// Unicode case folding, e.g. "Go" and "GO".
+func EqualFold(t checkmate.TestingT, actual, expected string, msgAndArgs ...any) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if passed := check.EqualFold(t, actual, expected, msgAndArgs...); !passed {
+		t.FailNow()
+	}
+}
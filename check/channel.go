@@ -0,0 +1,203 @@
+package check
+
+import (
+	"time"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Receives checks whether a value is received from ch within timeout, and
+// returns it. Receiving from a closed channel fails.
+//
+//	if event, ok := check.Receives(t, events, time.Second); ok {
+//		check.Equal(t, event.Kind, "created")
+//	}
+func Receives[T any](t checkmate.TestingT, ch <-chan T, timeout time.Duration, msgAndArgs ...any) (T, bool) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	value, received, closed := receive(ch, timeout)
+	if len(msgAndArgs) == 0 {
+		if closed {
+			msgAndArgs = []any{"expected to receive a value within %v, but the channel was closed", timeout}
+		} else {
+			msgAndArgs = []any{"expected to receive a value within %v", timeout}
+		}
+	}
+
+	return value, check(t, received, msgAndArgs...)
+}
+
+// ReceivesValue checks whether a value deeply equal to expected is received
+// from ch within timeout. CompareOptions may be passed in msgAndArgs to
+// configure the comparison, as for DeepEqual.
+func ReceivesValue[T any](t checkmate.TestingT, ch <-chan T, expected T, timeout time.Duration, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	_, messages := splitOptions[CompareOption](msgAndArgs)
+	value, passed := Receives(t, ch, timeout, messages...)
+	if !passed {
+		return false
+	}
+
+	return DeepEqual(t, value, expected, msgAndArgs...)
+}
+
+// NotReceives checks whether nothing is received from ch for the whole
+// duration. A channel which is closed during that time fails, since
+// receiving from it succeeds immediately.
+func NotReceives[T any](t checkmate.TestingT, ch <-chan T, duration time.Duration, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	value, received, closed := receive(ch, duration)
+	if len(msgAndArgs) == 0 {
+		if closed {
+			msgAndArgs = []any{"expected to receive nothing for %v, but the channel was closed", duration}
+		} else {
+			msgAndArgs = []any{"expected to receive nothing for %v, got %#v", duration, value}
+		}
+	}
+
+	return check(t, !received && !closed, msgAndArgs...)
+}
+
+// Closed checks whether ch is closed within timeout. Receiving a value from
+// ch first fails, so use Drains for a channel which may still be buffering
+// values.
+func Closed[T any](t checkmate.TestingT, ch <-chan T, timeout time.Duration, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	value, received, closed := receive(ch, timeout)
+	if len(msgAndArgs) == 0 {
+		if received {
+			msgAndArgs = []any{"expected the channel to be closed, but received %#v", value}
+		} else {
+			msgAndArgs = []any{"expected the channel to be closed within %v", timeout}
+		}
+	}
+
+	return check(t, closed, msgAndArgs...)
+}
+
+// Drains checks whether ch is closed within timeout, receiving every value
+// sent before that, and returns the values.
+//
+//	go pipeline(in, out)
+//	results, _ := check.Drains(t, out, time.Second)
+func Drains[T any](t checkmate.TestingT, ch <-chan T, timeout time.Duration, msgAndArgs ...any) ([]T, bool) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	values, closed := receiveAll(ch, -1, timeout)
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected the channel to be closed within %v, received %d values: %#v", timeout, len(values), values,
+		}
+	}
+
+	return values, check(t, closed, msgAndArgs...)
+}
+
+// ReceivesAll checks whether the values received from ch within timeout
+// deeply equal expected, in order. Exactly len(expected) values are
+// received, so later values are left in the channel. CompareOptions may be
+// passed in msgAndArgs to configure the comparison, as for DeepEqual.
+func ReceivesAll[T any](t checkmate.TestingT, ch <-chan T, expected []T, timeout time.Duration, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	_, messages := splitOptions[CompareOption](msgAndArgs)
+	values, passed := receivesN(t, ch, len(expected), timeout, messages...)
+	if !passed {
+		return false
+	}
+
+	return DeepEqual(t, values, expected, msgAndArgs...)
+}
+
+// ReceivesAllInAnyOrder is like ReceivesAll, but the values may be received
+// in any order. They are compared as by ElementsMatch, which lists the
+// missing and extra values on failure and does not take CompareOptions, so
+// any in msgAndArgs are ignored.
+func ReceivesAllInAnyOrder[T any](
+	t checkmate.TestingT, ch <-chan T, expected []T, timeout time.Duration, msgAndArgs ...any,
+) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	_, messages := splitOptions[CompareOption](msgAndArgs)
+	values, passed := receivesN(t, ch, len(expected), timeout, messages...)
+	if !passed {
+		return false
+	}
+
+	return ElementsMatch(t, values, expected, messages...)
+}
+
+// receivesN checks whether n values are received from ch within timeout.
+func receivesN[T any](t checkmate.TestingT, ch <-chan T, n int, timeout time.Duration, msgAndArgs ...any) ([]T, bool) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	values, closed := receiveAll(ch, n, timeout)
+	if len(msgAndArgs) == 0 {
+		if closed {
+			msgAndArgs = []any{
+				"expected to receive %d values, but the channel was closed after %d: %#v", n, len(values), values,
+			}
+		} else {
+			msgAndArgs = []any{
+				"expected to receive %d values within %v, got %d: %#v", n, timeout, len(values), values,
+			}
+		}
+	}
+
+	return values, check(t, len(values) == n, msgAndArgs...)
+}
+
+// receive waits up to timeout for a value from ch. It reports whether a
+// value was received and whether the channel was closed instead.
+func receive[T any](ch <-chan T, timeout time.Duration) (value T, received, closed bool) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case value, ok := <-ch:
+		return value, ok, !ok
+	case <-timer.C:
+		return value, false, false
+	}
+}
+
+// receiveAll receives values from ch until n values are received, ch is
+// closed, or timeout elapses for all of them together. A negative n
+// receives until ch is closed. It reports whether the channel was closed.
+func receiveAll[T any](ch <-chan T, n int, timeout time.Duration) ([]T, bool) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	values := make([]T, 0, max(n, 0))
+	for n < 0 || len(values) < n {
+		select {
+		case value, ok := <-ch:
+			if !ok {
+				return values, true
+			}
+			values = append(values, value)
+		case <-timer.C:
+			return values, false
+		}
+	}
+	return values, false
+}
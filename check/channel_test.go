@@ -0,0 +1,137 @@
+package check
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+// sent returns a channel holding values, which is closed when closed is
+// true.
+func sent(closed bool, values ...int) chan int {
+	ch := make(chan int, len(values))
+	for _, value := range values {
+		ch <- value
+	}
+	if closed {
+		close(ch)
+	}
+	return ch
+}
+
+func TestChannelChecks(t *testing.T) {
+	const timeout = 10 * time.Millisecond
+
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"Receives", func(t checkmate.TestingT) bool {
+			value, passed := Receives(t, sent(false, 1), timeout)
+			return passed && value == 1
+		}, true, ""},
+		{"Receives timeout", func(t checkmate.TestingT) bool {
+			_, passed := Receives(t, sent(false), timeout)
+			return passed
+		}, false, "expected to receive a value within 10ms"},
+		{"Receives closed", func(t checkmate.TestingT) bool {
+			_, passed := Receives(t, sent(true), timeout)
+			return passed
+		}, false, "expected to receive a value within 10ms, but the channel was closed"},
+		{"ReceivesValue", func(t checkmate.TestingT) bool { return ReceivesValue(t, sent(false, 1), 1, timeout) },
+			true, ""},
+		{"ReceivesValue mismatch", func(t checkmate.TestingT) bool { return ReceivesValue(t, sent(false, 2), 1, timeout) },
+			false, "mismatch (-expected +actual):\n"},
+		{"NotReceives", func(t checkmate.TestingT) bool { return NotReceives(t, sent(false), timeout) }, true, ""},
+		{"NotReceives received", func(t checkmate.TestingT) bool { return NotReceives(t, sent(false, 1), timeout) },
+			false, "expected to receive nothing for 10ms, got 1"},
+		{"NotReceives closed", func(t checkmate.TestingT) bool { return NotReceives(t, sent(true), timeout) },
+			false, "expected to receive nothing for 10ms, but the channel was closed"},
+		{"Closed", func(t checkmate.TestingT) bool { return Closed(t, sent(true), timeout) }, true, ""},
+		{"Closed received", func(t checkmate.TestingT) bool { return Closed(t, sent(true, 1), timeout) },
+			false, "expected the channel to be closed, but received 1"},
+		{"Closed timeout", func(t checkmate.TestingT) bool { return Closed(t, sent(false), timeout) },
+			false, "expected the channel to be closed within 10ms"},
+		{"Drains", func(t checkmate.TestingT) bool {
+			values, passed := Drains(t, sent(true, 1, 2), timeout)
+			return passed && len(values) == 2
+		}, true, ""},
+		{"Drains timeout", func(t checkmate.TestingT) bool {
+			_, passed := Drains(t, sent(false, 1, 2), timeout)
+			return passed
+		}, false, "expected the channel to be closed within 10ms, received 2 values: []int{1, 2}"},
+		{"ReceivesAll", func(t checkmate.TestingT) bool {
+			return ReceivesAll(t, sent(false, 1, 2, 3), []int{1, 2}, timeout)
+		}, true, ""},
+		{"ReceivesAll empty", func(t checkmate.TestingT) bool {
+			return ReceivesAll(t, sent(false, 1), []int{}, timeout)
+		}, true, ""},
+		{"Drains empty", func(t checkmate.TestingT) bool {
+			values, passed := Drains(t, sent(true), timeout)
+			return passed && values != nil
+		}, true, ""},
+		{"ReceivesAll out of order", func(t checkmate.TestingT) bool {
+			return ReceivesAll(t, sent(false, 2, 1), []int{1, 2}, timeout)
+		}, false, "mismatch (-expected +actual):\n"},
+		{"ReceivesAll timeout", func(t checkmate.TestingT) bool {
+			return ReceivesAll(t, sent(false, 1), []int{1, 2}, timeout)
+		}, false, "expected to receive 2 values within 10ms, got 1: []int{1}"},
+		{"ReceivesAll closed", func(t checkmate.TestingT) bool {
+			return ReceivesAll(t, sent(true, 1), []int{1, 2}, timeout)
+		}, false, "expected to receive 2 values, but the channel was closed after 1: []int{1}"},
+		{"ReceivesAllInAnyOrder", func(t checkmate.TestingT) bool {
+			return ReceivesAllInAnyOrder(t, sent(false, 2, 1), []int{1, 2}, timeout)
+		}, true, ""},
+		{"ReceivesAllInAnyOrder mismatch", func(t checkmate.TestingT) bool {
+			return ReceivesAllInAnyOrder(t, sent(false, 2, 3), []int{1, 2}, timeout)
+		}, false, "elements do not match:\n" +
+			"  missing (in expected, not in actual): [1]\n" +
+			"  extra (in actual, not in expected): [3]"},
+		{"ReceivesAllInAnyOrder ignores compare options", func(t checkmate.TestingT) bool {
+			return ReceivesAllInAnyOrder(t, sent(false, 2, 3), []int{1, 2}, timeout, FloatTolerance(1))
+		}, false, "elements do not match:\n" +
+			"  missing (in expected, not in actual): [1]\n" +
+			"  extra (in actual, not in expected): [3]"},
+		{"ReceivesAllInAnyOrder timeout with compare options", func(t checkmate.TestingT) bool {
+			return ReceivesAllInAnyOrder(t, sent(false, 1), []int{1, 2}, timeout, FloatTolerance(1))
+		}, false, "expected to receive 2 values within 10ms, got 1: []int{1}"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || !strings.HasPrefix(mockT.Logs[0], tc.logMessage)) {
+				t.Errorf("%s: expected log message starting with '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestReceivesWaitsForSender(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	ch := make(chan string)
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		ch <- "done"
+	}()
+
+	value, passed := Receives(mockT, ch, time.Second)
+
+	if !passed || value != "done" {
+		t.Errorf("expected to receive \"done\", got %q, logs: %v", value, mockT.Logs)
+	}
+}
@@ -7,47 +7,69 @@ import (
 	"strings"
 
 	"github.com/eugenetriguba/checkmate"
-	"github.com/google/go-cmp/cmp"
 )
 
 type helperT interface {
 	Helper()
 }
 
-// Nil checks whether the value equals nil.
+// Nil checks whether the value equals nil. Typed nils, such as a nil map,
+// slice, channel, function, or pointer stored in the interface, count as nil.
 func Nil(t checkmate.TestingT, value any, msgAndArgs ...any) bool {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
 
 	if len(msgAndArgs) == 0 {
-		msgAndArgs = []any{"expected value to be nil, got %v", value}
+		msgAndArgs = []any{"expected value to be nil, got %s", describeValue(value)}
 	}
 
-	isNil := false
-	val := reflect.ValueOf(value)
-	if val.Kind() == reflect.Ptr {
-		if val.IsNil() {
-			isNil = true
+	return check(t, isNil(value), msgAndArgs...)
+}
+
+// NotNil checks whether the value does not equal nil. It is the exact
+// inverse of Nil, so typed nils fail the check.
+func NotNil(t checkmate.TestingT, value any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		if value == nil {
+			msgAndArgs = []any{"expected value to not be nil, got nil"}
+		} else {
+			msgAndArgs = []any{"expected value to not be nil, got nil %T", value}
 		}
-	} else if value == nil {
-		isNil = true
 	}
 
-	return check(t, isNil, msgAndArgs...)
+	return check(t, !isNil(value), msgAndArgs...)
 }
 
-// NotNil checks whether the value does not equal nil.
-func NotNil(t checkmate.TestingT, value any, msgAndArgs ...any) bool {
+// Zero checks whether the value is the zero value of its type. A nil
+// value is considered zero.
+func Zero(t checkmate.TestingT, value any, msgAndArgs ...any) bool {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
 
 	if len(msgAndArgs) == 0 {
-		msgAndArgs = []any{"expected value to not be nil, got nil"}
+		msgAndArgs = []any{"expected zero value, got %s", describeValue(value)}
 	}
 
-	return check(t, value != nil, msgAndArgs...)
+	return check(t, isZero(value), msgAndArgs...)
+}
+
+// NotZero checks whether the value is not the zero value of its type.
+func NotZero(t checkmate.TestingT, value any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected a non-zero value, got %s", describeValue(value)}
+	}
+
+	return check(t, !isZero(value), msgAndArgs...)
 }
 
 // True checks whether the condition is true.
@@ -83,7 +105,11 @@ func ErrorIs(t checkmate.TestingT, err error, target error, msgAndArgs ...any) b
 	}
 
 	if len(msgAndArgs) == 0 {
-		msgAndArgs = []any{"expected error %v to have error %v in its tree", err, target}
+		if err == nil {
+			msgAndArgs = []any{"expected error %v in the error tree, got nil", target}
+		} else {
+			msgAndArgs = []any{"expected error %v to have error %v in its tree", err, target}
+		}
 	}
 
 	return check(t, errors.Is(err, target), msgAndArgs...)
@@ -104,12 +130,19 @@ func NotErrorIs(t checkmate.TestingT, err error, target error, msgAndArgs ...any
 }
 
 // ErrorContains checks whether the given err contains the errText
-// in the err.Error() output.
+// in the err.Error() output. A nil err fails the check.
 func ErrorContains(t checkmate.TestingT, err error, errText string, msgAndArgs ...any) bool {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
 
+	if err == nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"expected err to contain %s, got nil error", errText}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
 	if len(msgAndArgs) == 0 {
 		msgAndArgs = []any{"expected err to contain %s, got %s", errText, err.Error()}
 	}
@@ -118,7 +151,7 @@ func ErrorContains(t checkmate.TestingT, err error, errText string, msgAndArgs .
 }
 
 // NotErrorContains checks whether the given err does not contain the errText
-// in the err.Error() output.
+// in the err.Error() output. Like NotErrorIs, a nil err passes the check.
 func NotErrorContains(t checkmate.TestingT, err error, errText string, msgAndArgs ...any) bool {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
@@ -128,62 +161,146 @@ func NotErrorContains(t checkmate.TestingT, err error, errText string, msgAndArg
 		msgAndArgs = []any{"expected err to contain not %s, got that it does", errText}
 	}
 
-	return check(t, !strings.Contains(err.Error(), errText), msgAndArgs...)
+	return check(t, err == nil || !strings.Contains(err.Error(), errText), msgAndArgs...)
 }
 
 // DeepEqual checks if two values are deeply equal. If they are not equal,
-// it logs the differences.
+// it logs the differences. CompareOptions may be passed in msgAndArgs to
+// configure the comparison.
 func DeepEqual(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) bool {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
 
-	diff := cmp.Diff(expected, actual)
+	opts, msgAndArgs := splitOptions[CompareOption](msgAndArgs)
+	equal, diff, err := newCompareConfig(opts...).compare(expected, actual)
+	if err != nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"cannot compare the values: %v", err}
+		}
+		return check(t, false, msgAndArgs...)
+	}
 	if len(msgAndArgs) == 0 {
 		msgAndArgs = []any{"mismatch (-expected +actual):\n%s", diff}
 	}
 
-	return check(t, diff == "", msgAndArgs...)
+	return check(t, equal, msgAndArgs...)
 }
 
-// NotDeepEqual checks if two values are not deeply equal.
+// NotDeepEqual checks if two values are not deeply equal. CompareOptions
+// may be passed in msgAndArgs to configure the comparison.
 func NotDeepEqual(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) bool {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
 
-	diff := cmp.Diff(expected, actual)
+	opts, msgAndArgs := splitOptions[CompareOption](msgAndArgs)
+	equal, _, err := newCompareConfig(opts...).compare(expected, actual)
+	if err != nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"cannot compare the values: %v", err}
+		}
+		return check(t, false, msgAndArgs...)
+	}
 	if len(msgAndArgs) == 0 {
 		msgAndArgs = []any{"expected %v to not equal %v, got that they're equal", actual, expected}
 	}
-	return check(t, diff != "", msgAndArgs...)
+	return check(t, !equal, msgAndArgs...)
 }
 
-// Equal checks if two primitive values are equal.
+// Equal checks if two primitive values are equal. Values whose dynamic type
+// cannot be compared with ==, such as slices and maps, fail the check with a
+// suggestion to use DeepEqual instead of panicking. Unequal strings are
+// reported with a diff that points at the first difference.
 func Equal(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) bool {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
 
-	if len(msgAndArgs) == 0 {
-		msgAndArgs = []any{"expected %v to equal %v", actual, expected}
+	equal, problem := compareValues(actual, expected)
+	if problem != "" {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"%s, use DeepEqual instead", problem}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
+	if len(msgAndArgs) == 0 && !equal {
+		actualStr, actualIsStr := actual.(string)
+		expectedStr, expectedIsStr := expected.(string)
+		if actualIsStr && expectedIsStr {
+			msgAndArgs = []any{"%s", describeStringMismatch(actualStr, expectedStr)}
+		} else if sameType(actual, expected) {
+			msgAndArgs = []any{"expected %v to equal %v", actual, expected}
+		} else {
+			msgAndArgs = []any{
+				"expected %s to equal %s, the types differ", describeValue(actual), describeValue(expected),
+			}
+		}
 	}
 
-	return check(t, actual == expected, msgAndArgs...)
+	return check(t, equal, msgAndArgs...)
 }
 
 // NotEqual checks if two values are not equal. It fails the test if
-// the values are equal.
+// the values are equal. Like Equal, values that cannot be compared with ==
+// fail the check.
 func NotEqual(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) bool {
 	if ht, ok := t.(helperT); ok {
 		ht.Helper()
 	}
 
+	equal, problem := compareValues(actual, expected)
+	if problem != "" {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"%s, use NotDeepEqual instead", problem}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
 	if len(msgAndArgs) == 0 {
 		msgAndArgs = []any{"expected %v to not equal %v", actual, expected}
 	}
 
-	return check(t, actual != expected, msgAndArgs...)
+	return check(t, !equal, msgAndArgs...)
+}
+
+// EqualOf is the type-safe counterpart to Equal. Both values must share the
+// same comparable type, so comparing e.g. an int32 to an untyped int constant
+// is resolved at compile time instead of failing at runtime.
+func EqualOf[T comparable](t checkmate.TestingT, actual, expected T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return Equal(t, actual, expected, msgAndArgs...)
+}
+
+// NotEqualOf is the type-safe counterpart to NotEqual.
+func NotEqualOf[T comparable](t checkmate.TestingT, actual, expected T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return NotEqual(t, actual, expected, msgAndArgs...)
+}
+
+// DeepEqualOf is the type-safe counterpart to DeepEqual.
+func DeepEqualOf[T any](t checkmate.TestingT, actual, expected T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return DeepEqual(t, actual, expected, msgAndArgs...)
+}
+
+// NotDeepEqualOf is the type-safe counterpart to NotDeepEqual.
+func NotDeepEqualOf[T any](t checkmate.TestingT, actual, expected T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return NotDeepEqual(t, actual, expected, msgAndArgs...)
 }
 
 // Check evaluates a boolean condition and if the condition is false,
@@ -210,3 +327,61 @@ func check(t checkmate.TestingT, condition bool, msgAndArgs ...any) bool {
 
 	return condition
 }
+
+// isNil reports whether value is nil or holds a nil value of a nillable kind.
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map,
+		reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return val.IsNil()
+	default:
+		return false
+	}
+}
+
+// isZero reports whether value is nil or the zero value of its type.
+func isZero(value any) bool {
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
+
+// describeValue formats value along with its dynamic type, e.g. int32(5).
+func describeValue(value any) string {
+	if value == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%T(%v)", value, value)
+}
+
+// compareValues compares two values with ==. If the comparison would panic
+// because the dynamic type is not comparable, it instead returns a problem
+// describing why the values cannot be compared.
+func compareValues(actual, expected any) (equal bool, problem string) {
+	if !sameType(actual, expected) {
+		return false, ""
+	}
+
+	if actual != nil && !reflect.TypeOf(actual).Comparable() {
+		return false, fmt.Sprintf("cannot compare %T values with ==", actual)
+	}
+
+	// Comparable types can still panic, e.g. a struct with an interface
+	// field that holds a slice.
+	defer func() {
+		if r := recover(); r != nil {
+			equal = false
+			problem = fmt.Sprintf("cannot compare %T values with ==: %v", actual, r)
+		}
+	}()
+
+	return actual == expected, ""
+}
+
+// sameType reports whether both values have the same dynamic type.
+func sameType(actual, expected any) bool {
+	return reflect.TypeOf(actual) == reflect.TypeOf(expected)
+}
@@ -3,9 +3,11 @@ package check
 import (
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/eugenetriguba/checkmate"
 	"github.com/eugenetriguba/checkmate/internal/cmtest"
@@ -109,6 +111,448 @@ func wrappedCheckNotEqual(t checkmate.TestingT, args []any) bool {
 	}
 }
 
+func wrappedCheckEqualOf(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return EqualOf(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		return EqualOf(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedCheckNotEqualOf(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return NotEqualOf(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		return NotEqualOf(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedCheckDeepEqualOf(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return DeepEqualOf(t, args[0].([]int), args[1].([]int), args[2:]...)
+	} else {
+		return DeepEqualOf(t, args[0].([]int), args[1].([]int))
+	}
+}
+
+func wrappedCheckNotDeepEqualOf(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return NotDeepEqualOf(t, args[0].([]int), args[1].([]int), args[2:]...)
+	} else {
+		return NotDeepEqualOf(t, args[0].([]int), args[1].([]int))
+	}
+}
+
+func wrappedCheckMatches(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return Matches(t, args[0], args[1].(Matcher), args[2:]...)
+	} else {
+		return Matches(t, args[0], args[1].(Matcher))
+	}
+}
+
+func wrappedCheckLen(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return Len(t, args[0], args[1].(int), args[2:]...)
+	} else {
+		return Len(t, args[0], args[1].(int))
+	}
+}
+
+func wrappedCheckEmpty(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return Empty(t, args[0], args[1:]...)
+	} else {
+		return Empty(t, args[0])
+	}
+}
+
+func wrappedCheckNotEmpty(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return NotEmpty(t, args[0], args[1:]...)
+	} else {
+		return NotEmpty(t, args[0])
+	}
+}
+
+func wrappedCheckContains(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return Contains(t, args[0], args[1], args[2:]...)
+	} else {
+		return Contains(t, args[0], args[1])
+	}
+}
+
+func wrappedCheckNotContains(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return NotContains(t, args[0], args[1], args[2:]...)
+	} else {
+		return NotContains(t, args[0], args[1])
+	}
+}
+
+func wrappedCheckElementsMatch(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return ElementsMatch(t, args[0], args[1], args[2:]...)
+	} else {
+		return ElementsMatch(t, args[0], args[1])
+	}
+}
+
+func wrappedCheckSubset(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return Subset(t, args[0], args[1], args[2:]...)
+	} else {
+		return Subset(t, args[0], args[1])
+	}
+}
+
+func wrappedCheckPanics(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return Panics(t, args[0].(func()), args[1:]...)
+	} else {
+		return Panics(t, args[0].(func()))
+	}
+}
+
+func wrappedCheckNotPanics(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return NotPanics(t, args[0].(func()), args[1:]...)
+	} else {
+		return NotPanics(t, args[0].(func()))
+	}
+}
+
+func wrappedCheckPanicsWithValue(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return PanicsWithValue(t, args[0].(func()), args[1], args[2:]...)
+	} else {
+		return PanicsWithValue(t, args[0].(func()), args[1])
+	}
+}
+
+func wrappedCheckPanicsWithError(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return PanicsWithError(t, args[0].(func()), args[1].(error), args[2:]...)
+	} else {
+		return PanicsWithError(t, args[0].(func()), args[1].(error))
+	}
+}
+
+func wrappedCheckPanicsMatching(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return PanicsMatching(t, args[0].(func()), args[1].(string), args[2:]...)
+	} else {
+		return PanicsMatching(t, args[0].(func()), args[1].(string))
+	}
+}
+
+func wrappedCheckEventually(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return Eventually(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration), args[3:]...)
+	} else {
+		return Eventually(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration))
+	}
+}
+
+func wrappedCheckConsistently(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return Consistently(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration), args[3:]...)
+	} else {
+		return Consistently(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration))
+	}
+}
+
+func wrappedCheckNever(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return Never(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration), args[3:]...)
+	} else {
+		return Never(t, args[0].(func() bool), args[1].(time.Duration), args[2].(time.Duration))
+	}
+}
+
+func wrappedCheckEventuallyWithT(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return EventuallyWithT(t, args[0].(func(checkmate.TestingT)), args[1].(time.Duration), args[2].(time.Duration), args[3:]...)
+	} else {
+		return EventuallyWithT(t, args[0].(func(checkmate.TestingT)), args[1].(time.Duration), args[2].(time.Duration))
+	}
+}
+
+func wrappedCheckErrorAs(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		_, passed := ErrorAs[*fs.PathError](t, args[0].(error), args[1:]...)
+		return passed
+	} else {
+		_, passed := ErrorAs[*fs.PathError](t, args[0].(error))
+		return passed
+	}
+}
+
+func wrappedCheckNoError(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return NoError(t, errorOrNil(args[0]), args[1:]...)
+	} else {
+		return NoError(t, errorOrNil(args[0]))
+	}
+}
+
+func wrappedCheckError(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return Error(t, errorOrNil(args[0]), args[1:]...)
+	} else {
+		return Error(t, errorOrNil(args[0]))
+	}
+}
+
+func wrappedCheckErrorEqualsString(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return ErrorEqualsString(t, errorOrNil(args[0]), args[1].(string), args[2:]...)
+	} else {
+		return ErrorEqualsString(t, errorOrNil(args[0]), args[1].(string))
+	}
+}
+
+func wrappedCheckErrorMatches(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return ErrorMatches(t, errorOrNil(args[0]), args[1].(string), args[2:]...)
+	} else {
+		return ErrorMatches(t, errorOrNil(args[0]), args[1].(string))
+	}
+}
+
+func wrappedCheckZero(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return Zero(t, args[0], args[1:]...)
+	} else {
+		return Zero(t, args[0])
+	}
+}
+
+func wrappedCheckNotZero(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return NotZero(t, args[0], args[1:]...)
+	} else {
+		return NotZero(t, args[0])
+	}
+}
+
+func wrappedCheckInDelta(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return InDelta(t, args[0].(float64), args[1].(float64), args[2].(float64), args[3:]...)
+	} else {
+		return InDelta(t, args[0].(float64), args[1].(float64), args[2].(float64))
+	}
+}
+
+func wrappedCheckInEpsilon(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return InEpsilon(t, args[0].(float64), args[1].(float64), args[2].(float64), args[3:]...)
+	} else {
+		return InEpsilon(t, args[0].(float64), args[1].(float64), args[2].(float64))
+	}
+}
+
+func wrappedCheckInULPs(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return InULPs(t, args[0].(float64), args[1].(float64), args[2].(uint64), args[3:]...)
+	} else {
+		return InULPs(t, args[0].(float64), args[1].(float64), args[2].(uint64))
+	}
+}
+
+func wrappedCheckInDeltaSlice(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return InDeltaSlice(t, args[0].([]float64), args[1].([]float64), args[2].(float64), args[3:]...)
+	} else {
+		return InDeltaSlice(t, args[0].([]float64), args[1].([]float64), args[2].(float64))
+	}
+}
+
+func wrappedCheckInDeltaMapValues(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return InDeltaMapValues(t, args[0].(map[string]float64), args[1].(map[string]float64), args[2].(float64), args[3:]...)
+	} else {
+		return InDeltaMapValues(t, args[0].(map[string]float64), args[1].(map[string]float64), args[2].(float64))
+	}
+}
+
+func wrappedCheckGreater(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return Greater(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		return Greater(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedCheckGreaterOrEqual(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return GreaterOrEqual(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		return GreaterOrEqual(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedCheckLess(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return Less(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		return Less(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedCheckLessOrEqual(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return LessOrEqual(t, args[0].(int), args[1].(int), args[2:]...)
+	} else {
+		return LessOrEqual(t, args[0].(int), args[1].(int))
+	}
+}
+
+func wrappedCheckBetween(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return Between(t, args[0].(int), args[1].(int), args[2].(int), args[3:]...)
+	} else {
+		return Between(t, args[0].(int), args[1].(int), args[2].(int))
+	}
+}
+
+func wrappedCheckPositive(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return Positive(t, args[0].(int), args[1:]...)
+	} else {
+		return Positive(t, args[0].(int))
+	}
+}
+
+func wrappedCheckNegative(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return Negative(t, args[0].(int), args[1:]...)
+	} else {
+		return Negative(t, args[0].(int))
+	}
+}
+
+func wrappedCheckIsSorted(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return IsSorted(t, args[0].([]int), args[1:]...)
+	} else {
+		return IsSorted(t, args[0].([]int))
+	}
+}
+
+func wrappedCheckIsStrictlyIncreasing(t checkmate.TestingT, args []any) bool {
+	if len(args) > 1 {
+		return IsStrictlyIncreasing(t, args[0].([]int), args[1:]...)
+	} else {
+		return IsStrictlyIncreasing(t, args[0].([]int))
+	}
+}
+
+func wrappedCheckHasPrefix(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return HasPrefix(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		return HasPrefix(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedCheckHasSuffix(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return HasSuffix(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		return HasSuffix(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedCheckContainsString(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return ContainsString(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		return ContainsString(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedCheckMatchesRegexp(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return MatchesRegexp(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		return MatchesRegexp(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedCheckEqualFold(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return EqualFold(t, args[0].(string), args[1].(string), args[2:]...)
+	} else {
+		return EqualFold(t, args[0].(string), args[1].(string))
+	}
+}
+
+func wrappedCheckJSONEq(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return JSONEq(t, args[0], args[1], args[2:]...)
+	} else {
+		return JSONEq(t, args[0], args[1])
+	}
+}
+
+func wrappedCheckJSONPath(t checkmate.TestingT, args []any) bool {
+	if len(args) > 3 {
+		return JSONPath(t, args[0], args[1].(string), args[2], args[3:]...)
+	} else {
+		return JSONPath(t, args[0], args[1].(string), args[2])
+	}
+}
+
+func wrappedCheckJSONContains(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return JSONContains(t, args[0], args[1], args[2:]...)
+	} else {
+		return JSONContains(t, args[0], args[1])
+	}
+}
+
+func wrappedCheckYAMLEq(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return YAMLEq(t, args[0], args[1], args[2:]...)
+	} else {
+		return YAMLEq(t, args[0], args[1])
+	}
+}
+
+func wrappedCheckXMLEq(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return XMLEq(t, args[0], args[1], args[2:]...)
+	} else {
+		return XMLEq(t, args[0], args[1])
+	}
+}
+
+func wrappedCheckNotReceives(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return NotReceives(t, args[0].(chan int), args[1].(time.Duration), args[2:]...)
+	} else {
+		return NotReceives(t, args[0].(chan int), args[1].(time.Duration))
+	}
+}
+
+func wrappedCheckClosed(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return Closed(t, args[0].(chan int), args[1].(time.Duration), args[2:]...)
+	} else {
+		return Closed(t, args[0].(chan int), args[1].(time.Duration))
+	}
+}
+
+func wrappedCheckGroup(t checkmate.TestingT, args []any) bool {
+	if len(args) > 2 {
+		return Group(t, args[0].(string), args[1].(func(checkmate.TestingT)), args[2:]...)
+	} else {
+		return Group(t, args[0].(string), args[1].(func(checkmate.TestingT)))
+	}
+}
+
 var passingTestFns = []struct {
 	name string
 	fn   checkFn
@@ -126,6 +570,60 @@ var passingTestFns = []struct {
 	{"CheckNotDeepEqual", wrappedCheckNotDeepEqual, []any{5, 6}},
 	{"CheckEqual", wrappedCheckEqual, []any{5, 5}},
 	{"CheckNotEqual", wrappedCheckNotEqual, []any{5, 10}},
+	{"CheckEqualOf", wrappedCheckEqualOf, []any{5, 5}},
+	{"CheckNotEqualOf", wrappedCheckNotEqualOf, []any{5, 10}},
+	{"CheckDeepEqualOf", wrappedCheckDeepEqualOf, []any{[]int{1, 2}, []int{1, 2}}},
+	{"CheckNotDeepEqualOf", wrappedCheckNotDeepEqualOf, []any{[]int{1, 2}, []int{2, 1}}},
+	{"CheckMatches", wrappedCheckMatches, []any{5, EqualTo(5)}},
+	{"CheckLen", wrappedCheckLen, []any{[]int{1, 2}, 2}},
+	{"CheckEmpty", wrappedCheckEmpty, []any{[]int{}}},
+	{"CheckNotEmpty", wrappedCheckNotEmpty, []any{"a"}},
+	{"CheckContains", wrappedCheckContains, []any{[]int{1, 2}, 2}},
+	{"CheckNotContains", wrappedCheckNotContains, []any{[]int{1, 2}, 3}},
+	{"CheckElementsMatch", wrappedCheckElementsMatch, []any{[]int{1, 2}, []int{2, 1}}},
+	{"CheckSubset", wrappedCheckSubset, []any{[]int{1, 2, 3}, []int{3, 1}}},
+	{"CheckPanics", wrappedCheckPanics, []any{func() { panic("boom") }}},
+	{"CheckNotPanics", wrappedCheckNotPanics, []any{func() {}}},
+	{"CheckPanicsWithValue", wrappedCheckPanicsWithValue, []any{func() { panic("boom") }, "boom"}},
+	{"CheckPanicsWithError", wrappedCheckPanicsWithError, []any{func() { panic(os.ErrClosed) }, os.ErrClosed}},
+	{"CheckPanicsMatching", wrappedCheckPanicsMatching, []any{func() { panic("boom 42") }, `boom \d+`}},
+	{"CheckEventually", wrappedCheckEventually, []any{func() bool { return true }, 10 * time.Millisecond, time.Millisecond}},
+	{"CheckConsistently", wrappedCheckConsistently, []any{func() bool { return true }, 10 * time.Millisecond, time.Millisecond}},
+	{"CheckNever", wrappedCheckNever, []any{func() bool { return false }, 10 * time.Millisecond, time.Millisecond}},
+	{"CheckEventuallyWithT", wrappedCheckEventuallyWithT, []any{func(t checkmate.TestingT) {}, 10 * time.Millisecond, time.Millisecond}},
+	{"CheckErrorAs", wrappedCheckErrorAs, []any{&fs.PathError{Op: "open", Err: os.ErrNotExist}}},
+	{"CheckNoError", wrappedCheckNoError, []any{nil}},
+	{"CheckError", wrappedCheckError, []any{os.ErrClosed}},
+	{"CheckErrorEqualsString", wrappedCheckErrorEqualsString, []any{errors.New("boom"), "boom"}},
+	{"CheckErrorMatches", wrappedCheckErrorMatches, []any{errors.New("code 42"), `code \d+`}},
+	{"CheckZero", wrappedCheckZero, []any{""}},
+	{"CheckNotZero", wrappedCheckNotZero, []any{5}},
+	{"CheckInDelta", wrappedCheckInDelta, []any{1.0, 1.05, 0.1}},
+	{"CheckInEpsilon", wrappedCheckInEpsilon, []any{101.0, 100.0, 0.02}},
+	{"CheckInULPs", wrappedCheckInULPs, []any{0.1 + 0.2, 0.3, uint64(1)}},
+	{"CheckInDeltaSlice", wrappedCheckInDeltaSlice, []any{[]float64{1, 2}, []float64{1.01, 2}, 0.1}},
+	{"CheckInDeltaMapValues", wrappedCheckInDeltaMapValues, []any{map[string]float64{"a": 1}, map[string]float64{"a": 1.01}, 0.1}},
+	{"CheckGreater", wrappedCheckGreater, []any{2, 1}},
+	{"CheckGreaterOrEqual", wrappedCheckGreaterOrEqual, []any{1, 1}},
+	{"CheckLess", wrappedCheckLess, []any{1, 2}},
+	{"CheckLessOrEqual", wrappedCheckLessOrEqual, []any{1, 1}},
+	{"CheckBetween", wrappedCheckBetween, []any{2, 1, 3}},
+	{"CheckPositive", wrappedCheckPositive, []any{1}},
+	{"CheckNegative", wrappedCheckNegative, []any{-1}},
+	{"CheckIsSorted", wrappedCheckIsSorted, []any{[]int{1, 1, 2}}},
+	{"CheckIsStrictlyIncreasing", wrappedCheckIsStrictlyIncreasing, []any{[]int{1, 2}}},
+	{"CheckHasPrefix", wrappedCheckHasPrefix, []any{"checkmate", "check"}},
+	{"CheckHasSuffix", wrappedCheckHasSuffix, []any{"checkmate", "mate"}},
+	{"CheckContainsString", wrappedCheckContainsString, []any{"checkmate", "km"}},
+	{"CheckMatchesRegexp", wrappedCheckMatchesRegexp, []any{"v1.2.3", `^v\d+\.\d+\.\d+$`}},
+	{"CheckEqualFold", wrappedCheckEqualFold, []any{"Go", "GO"}},
+	{"CheckJSONEq", wrappedCheckJSONEq, []any{`{"a": [1, 2]}`, `{"a":[1,2]}`}},
+	{"CheckJSONPath", wrappedCheckJSONPath, []any{`{"a": [1, 2]}`, "$.a[1]", 2}},
+	{"CheckJSONContains", wrappedCheckJSONContains, []any{`{"a": 1, "b": 2}`, `{"b": 2}`}},
+	{"CheckYAMLEq", wrappedCheckYAMLEq, []any{"a: [1, 2]", "a:\n  - 1\n  - 2"}},
+	{"CheckXMLEq", wrappedCheckXMLEq, []any{`<a x="1" y="2"/>`, `<a y="2" x="1"></a>`}},
+	{"CheckNotReceives", wrappedCheckNotReceives, []any{(chan int)(nil), time.Millisecond}},
+	{"CheckGroup", wrappedCheckGroup, []any{"group", func(t checkmate.TestingT) {}}},
 }
 
 var failingTestFns = []struct {
@@ -145,6 +643,61 @@ var failingTestFns = []struct {
 	{"CheckNotDeepEqual", wrappedCheckNotDeepEqual, []any{5, 5}},
 	{"CheckEqual", wrappedCheckEqual, []any{5, 10}},
 	{"CheckNotEqual", wrappedCheckNotEqual, []any{5, 5}},
+	{"CheckEqualOf", wrappedCheckEqualOf, []any{5, 10}},
+	{"CheckNotEqualOf", wrappedCheckNotEqualOf, []any{5, 5}},
+	{"CheckDeepEqualOf", wrappedCheckDeepEqualOf, []any{[]int{1, 2}, []int{2, 1}}},
+	{"CheckNotDeepEqualOf", wrappedCheckNotDeepEqualOf, []any{[]int{1, 2}, []int{1, 2}}},
+	{"CheckMatches", wrappedCheckMatches, []any{5, EqualTo(10)}},
+	{"CheckLen", wrappedCheckLen, []any{[]int{1, 2}, 3}},
+	{"CheckEmpty", wrappedCheckEmpty, []any{[]int{1}}},
+	{"CheckNotEmpty", wrappedCheckNotEmpty, []any{""}},
+	{"CheckContains", wrappedCheckContains, []any{[]int{1, 2}, 3}},
+	{"CheckNotContains", wrappedCheckNotContains, []any{[]int{1, 2}, 2}},
+	{"CheckElementsMatch", wrappedCheckElementsMatch, []any{[]int{1, 2}, []int{1, 3}}},
+	{"CheckSubset", wrappedCheckSubset, []any{[]int{1, 2}, []int{3}}},
+	{"CheckPanics", wrappedCheckPanics, []any{func() {}}},
+	{"CheckNotPanics", wrappedCheckNotPanics, []any{func() { panic("boom") }}},
+	{"CheckPanicsWithValue", wrappedCheckPanicsWithValue, []any{func() { panic("boom") }, "bang"}},
+	{"CheckPanicsWithError", wrappedCheckPanicsWithError, []any{func() { panic(os.ErrClosed) }, os.ErrExist}},
+	{"CheckPanicsMatching", wrappedCheckPanicsMatching, []any{func() { panic("boom") }, `bang`}},
+	{"CheckEventually", wrappedCheckEventually, []any{func() bool { return false }, 10 * time.Millisecond, time.Millisecond}},
+	{"CheckEventuallyZeroInterval", wrappedCheckEventually, []any{func() bool { return true }, 10 * time.Millisecond, time.Duration(0)}},
+	{"CheckConsistently", wrappedCheckConsistently, []any{func() bool { return false }, 10 * time.Millisecond, time.Millisecond}},
+	{"CheckNever", wrappedCheckNever, []any{func() bool { return true }, 10 * time.Millisecond, time.Millisecond}},
+	{"CheckEventuallyWithT", wrappedCheckEventuallyWithT, []any{func(t checkmate.TestingT) { t.Fail() }, 10 * time.Millisecond, time.Millisecond}},
+	{"CheckErrorAs", wrappedCheckErrorAs, []any{os.ErrClosed}},
+	{"CheckNoError", wrappedCheckNoError, []any{os.ErrClosed}},
+	{"CheckError", wrappedCheckError, []any{nil}},
+	{"CheckErrorEqualsString", wrappedCheckErrorEqualsString, []any{errors.New("boom"), "bang"}},
+	{"CheckErrorMatches", wrappedCheckErrorMatches, []any{errors.New("code x"), `code \d+`}},
+	{"CheckZero", wrappedCheckZero, []any{"a"}},
+	{"CheckNotZero", wrappedCheckNotZero, []any{0}},
+	{"CheckInDelta", wrappedCheckInDelta, []any{1.0, 1.5, 0.1}},
+	{"CheckInEpsilon", wrappedCheckInEpsilon, []any{110.0, 100.0, 0.02}},
+	{"CheckInULPs", wrappedCheckInULPs, []any{1.0, 1.1, uint64(1)}},
+	{"CheckInDeltaSlice", wrappedCheckInDeltaSlice, []any{[]float64{1, 2}, []float64{1, 3}, 0.1}},
+	{"CheckInDeltaMapValues", wrappedCheckInDeltaMapValues, []any{map[string]float64{"a": 1}, map[string]float64{"a": 2}, 0.1}},
+	{"CheckGreater", wrappedCheckGreater, []any{1, 1}},
+	{"CheckGreaterOrEqual", wrappedCheckGreaterOrEqual, []any{0, 1}},
+	{"CheckLess", wrappedCheckLess, []any{1, 1}},
+	{"CheckLessOrEqual", wrappedCheckLessOrEqual, []any{2, 1}},
+	{"CheckBetween", wrappedCheckBetween, []any{4, 1, 3}},
+	{"CheckPositive", wrappedCheckPositive, []any{0}},
+	{"CheckNegative", wrappedCheckNegative, []any{0}},
+	{"CheckIsSorted", wrappedCheckIsSorted, []any{[]int{2, 1}}},
+	{"CheckIsStrictlyIncreasing", wrappedCheckIsStrictlyIncreasing, []any{[]int{1, 1}}},
+	{"CheckHasPrefix", wrappedCheckHasPrefix, []any{"checkmate", "mate"}},
+	{"CheckHasSuffix", wrappedCheckHasSuffix, []any{"checkmate", "check"}},
+	{"CheckContainsString", wrappedCheckContainsString, []any{"checkmate", "xyz"}},
+	{"CheckMatchesRegexp", wrappedCheckMatchesRegexp, []any{"v1.2", `^v\d+\.\d+\.\d+$`}},
+	{"CheckEqualFold", wrappedCheckEqualFold, []any{"Go", "Rust"}},
+	{"CheckJSONEq", wrappedCheckJSONEq, []any{`{"a": 1}`, `{"a": 2}`}},
+	{"CheckJSONPath", wrappedCheckJSONPath, []any{`{"a": [1, 2]}`, "$.a[2]", 2}},
+	{"CheckJSONContains", wrappedCheckJSONContains, []any{`{"a": 1}`, `{"b": 2}`}},
+	{"CheckYAMLEq", wrappedCheckYAMLEq, []any{"a: 1", "a: 2"}},
+	{"CheckXMLEq", wrappedCheckXMLEq, []any{`<a>1</a>`, `<a>2</a>`}},
+	{"CheckClosed", wrappedCheckClosed, []any{(chan int)(nil), time.Millisecond}},
+	{"CheckGroup", wrappedCheckGroup, []any{"group", func(t checkmate.TestingT) { t.Fail() }}},
 }
 
 func TestOptionalMessageAndArgs(t *testing.T) {
@@ -244,7 +797,12 @@ func TestCheckEqual(t *testing.T) {
 		{"EqualFloats", 5.123, 5.123, false, []string{}},
 		{"UnequalFloats", 5.123, 5.1234, true, []string{"expected 5.123 to equal 5.1234"}},
 		{"EqualStrings", "test", "test", false, []string{}},
-		{"UnequalStrings", "test", "fail", true, []string{"expected test to equal fail"}},
+		{"UnequalStrings", "test", "tent", true, []string{
+			"strings differ:\n" +
+				"  actual:   \"test\"\n" +
+				"  expected: \"tent\"\n" +
+				"               ^ first difference at rune 2",
+		}},
 		{"EqualBooleans", true, true, false, []string{}},
 		{"UnequalBooleans", false, true, true, []string{"expected false to equal true"}},
 	}
@@ -354,3 +912,168 @@ func TestCheckNilForNilPointer(t *testing.T) {
 		t.Fatal("check.Nil should have returned true for nil pointer")
 	}
 }
+
+func TestCheckEqualOfInfersTypeFromArguments(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	var value int32 = 5
+
+	passed := EqualOf(mockT, value, 5)
+
+	if !passed {
+		t.Fatalf("check.EqualOf should have passed for int32(5) and untyped 5, logs: %v", mockT.Logs)
+	}
+}
+
+// errorOrNil converts a table argument to an error, allowing untyped nil.
+func errorOrNil(arg any) error {
+	err, _ := arg.(error)
+	return err
+}
+
+func TestCheckNilAndNotNilAgreeForNillableKinds(t *testing.T) {
+	var nilInterface error
+	testCases := []struct {
+		name  string
+		value any
+		isNil bool
+	}{
+		{"Untyped nil", nil, true},
+		{"Nil interface", nilInterface, true},
+		{"Nil pointer", (*cmtest.MockT)(nil), true},
+		{"Nil slice", []int(nil), true},
+		{"Nil map", map[string]int(nil), true},
+		{"Nil channel", (chan int)(nil), true},
+		{"Nil func", (func())(nil), true},
+		{"Nil pointer to interface", (*error)(nil), true},
+		{"Empty slice", []int{}, false},
+		{"Empty map", map[string]int{}, false},
+		{"Zero int", 0, false},
+		{"Non-nil pointer", &cmtest.MockT{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nilT := &cmtest.MockT{}
+			notNilT := &cmtest.MockT{}
+
+			nilPassed := Nil(nilT, tc.value)
+			notNilPassed := NotNil(notNilT, tc.value)
+
+			if nilPassed != tc.isNil {
+				t.Errorf("%s: check.Nil returned %v, want %v", tc.name, nilPassed, tc.isNil)
+			}
+			if notNilPassed == tc.isNil {
+				t.Errorf("%s: check.NotNil returned %v, want %v", tc.name, notNilPassed, !tc.isNil)
+			}
+		})
+	}
+}
+
+func TestCheckNilMessagesNameDynamicType(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		logMessage string
+	}{
+		{"Nil", func(t checkmate.TestingT) bool { return Nil(t, int32(5)) }, "expected value to be nil, got int32(5)"},
+		{"NotNil untyped", func(t checkmate.TestingT) bool { return NotNil(t, nil) }, "expected value to not be nil, got nil"},
+		{"NotNil typed", func(t checkmate.TestingT) bool { return NotNil(t, []int(nil)) }, "expected value to not be nil, got nil []int"},
+		{"Zero", func(t checkmate.TestingT) bool { return Zero(t, "a") }, "expected zero value, got string(a)"},
+		{"NotZero", func(t checkmate.TestingT) bool { return NotZero(t, uint8(0)) }, "expected a non-zero value, got uint8(0)"},
+		{"NotZero nil", func(t checkmate.TestingT) bool { return NotZero(t, nil) }, "expected a non-zero value, got nil"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			if tc.fn(mockT) {
+				t.Fatalf("%s: expected the check to fail", tc.name)
+			}
+			if len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestCheckZeroForStructs(t *testing.T) {
+	type point struct{ X, Y int }
+	mockT := &cmtest.MockT{}
+
+	if !Zero(mockT, point{}) || !NotZero(mockT, point{X: 1}) {
+		t.Fatalf("expected struct zero checks to pass, logs: %v", mockT.Logs)
+	}
+}
+
+func TestCheckEqualWithNonComparableValues(t *testing.T) {
+	type holder struct{ Value any }
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		logMessage string
+	}{
+		{
+			"Equal slices",
+			func(t checkmate.TestingT) bool { return Equal(t, []int{1}, []int{1}) },
+			"cannot compare []int values with ==, use DeepEqual instead",
+		},
+		{
+			"Equal maps",
+			func(t checkmate.TestingT) bool { return Equal(t, map[string]int{}, map[string]int{}) },
+			"cannot compare map[string]int values with ==, use DeepEqual instead",
+		},
+		{
+			"Equal structs holding slices",
+			func(t checkmate.TestingT) bool { return Equal(t, holder{[]int{1}}, holder{[]int{1}}) },
+			"cannot compare check.holder values with ==: runtime error: comparing uncomparable type []int, " +
+				"use DeepEqual instead",
+		},
+		{
+			"NotEqual funcs",
+			func(t checkmate.TestingT) bool { return NotEqual(t, func() {}, func() {}) },
+			"cannot compare func() values with ==, use NotDeepEqual instead",
+		},
+		{
+			"Equal slices with a custom message",
+			func(t checkmate.TestingT) bool { return Equal(t, []int{1}, []int{1}, "custom %d", 1) },
+			"custom 1",
+		},
+		{
+			"NotEqual funcs with a custom message",
+			func(t checkmate.TestingT) bool { return NotEqual(t, func() {}, func() {}, "custom") },
+			"custom",
+		},
+		{
+			"Equal type mismatch",
+			func(t checkmate.TestingT) bool { return Equal(t, int32(5), 5) },
+			"expected int32(5) to equal int(5), the types differ",
+		},
+		{
+			"Equal nil and value",
+			func(t checkmate.TestingT) bool { return Equal(t, nil, 5) },
+			"expected nil to equal int(5), the types differ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			if tc.fn(mockT) {
+				t.Fatalf("%s: expected the check to fail", tc.name)
+			}
+			if len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestCheckNotEqualWithDifferentTypes(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	if !NotEqual(mockT, int32(5), 5) || !NotEqual(mockT, []int{1}, "a") {
+		t.Fatalf("NotEqual should pass for values of different types, logs: %v", mockT.Logs)
+	}
+}
@@ -0,0 +1,92 @@
+package check
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// collectT is a checkmate.TestingT that records log messages and failures
+// instead of reporting them, so that checks can be run speculatively and
+// their outcome reported later.
+type collectT struct {
+	mu     sync.Mutex
+	logs   []string
+	failed bool
+}
+
+func (c *collectT) Log(args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.logs = append(c.logs, fmt.Sprint(args...))
+}
+
+func (c *collectT) Fail() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failed = true
+}
+
+// FailNow marks the collector as failed and stops the calling goroutine,
+// like testing.T.FailNow. Callbacks given a collectT are run on their own
+// goroutine by collect so that this only ends the callback.
+func (c *collectT) FailNow() {
+	c.Fail()
+	runtime.Goexit()
+}
+
+// Failed reports whether Fail or FailNow was called.
+func (c *collectT) Failed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.failed
+}
+
+// Logs returns a copy of the recorded log messages.
+func (c *collectT) Logs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string(nil), c.logs...)
+}
+
+// collect runs fn with a fresh collectT on a separate goroutine and waits
+// for it to finish. A panic inside fn is re-raised on the caller's goroutine.
+func collect(fn func(t checkmate.TestingT)) *collectT {
+	c := &collectT{}
+	runIsolated(func() { fn(c) })
+	return c
+}
+
+// runIsolated runs fn on a separate goroutine and waits for it to finish,
+// so that runtime.Goexit from a FailNow inside fn only ends fn. A panic
+// inside fn is re-raised on the caller's goroutine.
+func runIsolated(fn func()) {
+	done := make(chan struct{})
+	var panicked bool
+	var panicValue any
+
+	go func() {
+		defer close(done)
+		defer func() {
+			// runtime.Goexit from FailNow also runs deferred calls, but
+			// recover returns nil for it, so only real panics are kept.
+			if r := recover(); r != nil {
+				panicked = true
+				panicValue = r
+			}
+		}()
+
+		fn()
+	}()
+	<-done
+
+	if panicked {
+		panic(panicValue)
+	}
+}
@@ -0,0 +1,270 @@
+package check
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Len checks whether the value has the given length. The value must be
+// nil, which has a length of zero as it does for Empty, or a string, slice,
+// array, map, or channel.
+func Len(t checkmate.TestingT, value any, length int, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	actualLength, ok := lengthOf(value)
+	if !ok {
+		return check(t, false, "cannot get the length of %T", value)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to have length %d, got %d", value, length, actualLength}
+	}
+
+	return check(t, actualLength == length, msgAndArgs...)
+}
+
+// Empty checks whether the value is nil or has a length of zero. Non-nil
+// values must be a string, slice, array, map, or channel.
+func Empty(t checkmate.TestingT, value any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if value == nil {
+		return check(t, true)
+	}
+
+	length, ok := lengthOf(value)
+	if !ok {
+		return check(t, false, "cannot check whether %T is empty", value)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be empty, got length %d", value, length}
+	}
+
+	return check(t, length == 0, msgAndArgs...)
+}
+
+// NotEmpty checks whether the value has a length greater than zero. The
+// value must be a string, slice, array, map, or channel.
+func NotEmpty(t checkmate.TestingT, value any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to not be empty", value}
+	}
+
+	if value == nil {
+		return check(t, false, msgAndArgs...)
+	}
+
+	length, ok := lengthOf(value)
+	if !ok {
+		return check(t, false, "cannot check whether %T is empty", value)
+	}
+
+	return check(t, length > 0, msgAndArgs...)
+}
+
+// Contains checks whether the container holds the element. Strings are
+// searched for a substring, maps for a key, and slices and arrays for an
+// item that is deeply equal to the element.
+func Contains(t checkmate.TestingT, container, element any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	found, ok := containsElement(container, element)
+	if !ok {
+		return check(t, false, "cannot check whether %T contains %v", container, element)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to contain %v", container, element}
+	}
+
+	return check(t, found, msgAndArgs...)
+}
+
+// NotContains checks whether the container does not hold the element. It
+// accepts the same kinds of containers as Contains.
+func NotContains(t checkmate.TestingT, container, element any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	found, ok := containsElement(container, element)
+	if !ok {
+		return check(t, false, "cannot check whether %T contains %v", container, element)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to not contain %v", container, element}
+	}
+
+	return check(t, !found, msgAndArgs...)
+}
+
+// ElementsMatch checks whether two slices or arrays hold the same elements,
+// ignoring order. Duplicates must appear the same number of times in both.
+// On failure it lists the elements missing from actual and the extra
+// elements actual has.
+func ElementsMatch(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	actualVal, expectedVal := reflect.ValueOf(actual), reflect.ValueOf(expected)
+	if !isList(actualVal) || !isList(expectedVal) {
+		return check(t, false, "cannot compare elements of %T and %T, expected slices or arrays", actual, expected)
+	}
+
+	missing, extra := diffElements(expectedVal, actualVal)
+	if len(msgAndArgs) == 0 {
+		var report strings.Builder
+		report.WriteString("elements do not match:")
+		if len(missing) > 0 {
+			fmt.Fprintf(&report, "\n  missing (in expected, not in actual): %v", missing)
+		}
+		if len(extra) > 0 {
+			fmt.Fprintf(&report, "\n  extra (in actual, not in expected): %v", extra)
+		}
+		msgAndArgs = []any{"%s", report.String()}
+	}
+
+	return check(t, len(missing) == 0 && len(extra) == 0, msgAndArgs...)
+}
+
+// Subset checks whether every element of subset is present in list. For
+// slices and arrays each element of subset must be matched by a distinct
+// element of list. For maps each key of subset must be present in list
+// with a deeply equal value.
+func Subset(t checkmate.TestingT, list, subset any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	listVal, subsetVal := reflect.ValueOf(list), reflect.ValueOf(subset)
+
+	var missing any
+	switch {
+	case listVal.Kind() == reflect.Map && subsetVal.Kind() == reflect.Map:
+		if !subsetVal.Type().Key().AssignableTo(listVal.Type().Key()) {
+			return check(t, false, "cannot check whether %T is a subset of %T, their keys have different types", subset, list)
+		}
+		missingEntries := map[any]any{}
+		for _, key := range subsetVal.MapKeys() {
+			listEntry := listVal.MapIndex(key)
+			subsetEntry := subsetVal.MapIndex(key)
+			if !listEntry.IsValid() || !reflect.DeepEqual(listEntry.Interface(), subsetEntry.Interface()) {
+				missingEntries[key.Interface()] = subsetEntry.Interface()
+			}
+		}
+		if len(missingEntries) > 0 {
+			missing = missingEntries
+		}
+	case isList(listVal) && isList(subsetVal):
+		missingElements, _ := diffElements(subsetVal, listVal)
+		if len(missingElements) > 0 {
+			missing = missingElements
+		}
+	default:
+		return check(t, false, "cannot check whether %T is a subset of %T", subset, list)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be a subset of %v, missing: %v", subset, list, missing}
+	}
+
+	return check(t, missing == nil, msgAndArgs...)
+}
+
+// lengthOf returns the length of value and whether value has a length. A
+// nil value has a length of zero.
+func lengthOf(value any) (int, bool) {
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Invalid:
+		return 0, true
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return val.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// isList reports whether val is a slice or an array.
+func isList(val reflect.Value) bool {
+	return val.Kind() == reflect.Slice || val.Kind() == reflect.Array
+}
+
+// diffElements pairs up deeply equal elements of two lists, ignoring order.
+// It returns the elements of want that had no partner in got, and the
+// elements of got that had no partner in want.
+func diffElements(want, got reflect.Value) (missing, extra []any) {
+	matched := make([]bool, got.Len())
+
+	for i := 0; i < want.Len(); i++ {
+		wantElem := want.Index(i).Interface()
+		found := false
+		for j := 0; j < got.Len(); j++ {
+			if !matched[j] && reflect.DeepEqual(wantElem, got.Index(j).Interface()) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, wantElem)
+		}
+	}
+
+	for j := 0; j < got.Len(); j++ {
+		if !matched[j] {
+			extra = append(extra, got.Index(j).Interface())
+		}
+	}
+
+	return missing, extra
+}
+
+// containsElement reports whether container holds element. For strings the
+// element must be a substring, for maps it must be a key, and for slices and
+// arrays it must be deeply equal to one of the items. The second result is
+// false when container is not one of those kinds.
+func containsElement(container, element any) (found, ok bool) {
+	containerVal := reflect.ValueOf(container)
+
+	switch containerVal.Kind() {
+	case reflect.String:
+		elementStr, isStr := element.(string)
+		if !isStr {
+			return false, false
+		}
+		return strings.Contains(containerVal.String(), elementStr), true
+	case reflect.Map:
+		for _, key := range containerVal.MapKeys() {
+			if reflect.DeepEqual(key.Interface(), element) {
+				return true, true
+			}
+		}
+		return false, true
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < containerVal.Len(); i++ {
+			if reflect.DeepEqual(containerVal.Index(i).Interface(), element) {
+				return true, true
+			}
+		}
+		return false, true
+	default:
+		return false, false
+	}
+}
@@ -0,0 +1,181 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestCollectionChecks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{
+			"Len of map",
+			func(t checkmate.TestingT) bool { return Len(t, map[string]int{"a": 1}, 1) },
+			true, "",
+		},
+		{
+			"Len mismatch",
+			func(t checkmate.TestingT) bool { return Len(t, "abc", 2) },
+			false, "expected abc to have length 2, got 3",
+		},
+		{
+			"Len of nil",
+			func(t checkmate.TestingT) bool { return Len(t, nil, 0) },
+			true, "",
+		},
+		{
+			"Len of unsupported type",
+			func(t checkmate.TestingT) bool { return Len(t, 5, 1) },
+			false, "cannot get the length of int",
+		},
+		{
+			"Empty nil",
+			func(t checkmate.TestingT) bool { return Empty(t, nil) },
+			true, "",
+		},
+		{
+			"Empty nil map",
+			func(t checkmate.TestingT) bool { return Empty(t, map[string]int(nil)) },
+			true, "",
+		},
+		{
+			"Empty non-empty slice",
+			func(t checkmate.TestingT) bool { return Empty(t, []int{1, 2}) },
+			false, "expected [1 2] to be empty, got length 2",
+		},
+		{
+			"Empty unsupported type",
+			func(t checkmate.TestingT) bool { return Empty(t, 0) },
+			false, "cannot check whether int is empty",
+		},
+		{
+			"NotEmpty nil",
+			func(t checkmate.TestingT) bool { return NotEmpty(t, nil) },
+			false, "expected <nil> to not be empty",
+		},
+		{
+			"Contains substring",
+			func(t checkmate.TestingT) bool { return Contains(t, "hello world", "lo w") },
+			true, "",
+		},
+		{
+			"Contains map key",
+			func(t checkmate.TestingT) bool { return Contains(t, map[string]int{"a": 1}, "b") },
+			false, "expected map[a:1] to contain b",
+		},
+		{
+			"Contains array item",
+			func(t checkmate.TestingT) bool { return Contains(t, [2]string{"a", "b"}, "b") },
+			true, "",
+		},
+		{
+			"Contains struct item",
+			func(t checkmate.TestingT) bool {
+				type item struct{ ID int }
+				return Contains(t, []item{{1}, {2}}, item{2})
+			},
+			true, "",
+		},
+		{
+			"Contains non-string in string",
+			func(t checkmate.TestingT) bool { return Contains(t, "abc", 1) },
+			false, "cannot check whether string contains 1",
+		},
+		{
+			"NotContains substring",
+			func(t checkmate.TestingT) bool { return NotContains(t, "hello", "ell") },
+			false, "expected hello to not contain ell",
+		},
+		{
+			"ElementsMatch with duplicates",
+			func(t checkmate.TestingT) bool { return ElementsMatch(t, []int{1, 1, 2}, []int{1, 2, 1}) },
+			true, "",
+		},
+		{
+			"ElementsMatch lists missing and extra",
+			func(t checkmate.TestingT) bool { return ElementsMatch(t, []int{1, 2, 4, 4}, []int{3, 2, 1}) },
+			false,
+			"elements do not match:\n" +
+				"  missing (in expected, not in actual): [3]\n" +
+				"  extra (in actual, not in expected): [4 4]",
+		},
+		{
+			"ElementsMatch only missing",
+			func(t checkmate.TestingT) bool { return ElementsMatch(t, []int{1}, []int{1, 1}) },
+			false,
+			"elements do not match:\n" +
+				"  missing (in expected, not in actual): [1]",
+		},
+		{
+			"ElementsMatch non-lists",
+			func(t checkmate.TestingT) bool { return ElementsMatch(t, 1, []int{1}) },
+			false, "cannot compare elements of int and []int, expected slices or arrays",
+		},
+		{
+			"Subset of map",
+			func(t checkmate.TestingT) bool {
+				return Subset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2})
+			},
+			true, "",
+		},
+		{
+			"Subset of map with differing value",
+			func(t checkmate.TestingT) bool {
+				return Subset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3})
+			},
+			false, "expected map[b:3] to be a subset of map[a:1 b:2], missing: map[b:3]",
+		},
+		{
+			"Subset counts duplicates",
+			func(t checkmate.TestingT) bool { return Subset(t, []int{1, 2}, []int{1, 1}) },
+			false, "expected [1 1] to be a subset of [1 2], missing: [1]",
+		},
+		{
+			"Subset of maps with different key types",
+			func(t checkmate.TestingT) bool { return Subset(t, map[string]int{"a": 1}, map[int]int{1: 1}) },
+			false, "cannot check whether map[int]int is a subset of map[string]int, their keys have different types",
+		},
+		{
+			"Subset of mismatched kinds",
+			func(t checkmate.TestingT) bool { return Subset(t, []int{1}, map[int]int{}) },
+			false, "cannot check whether map[int]int is a subset of []int",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestExpectationCollectionMethods(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	passed := That(mockT, []int{1, 2}).ToHaveLen(2) &&
+		That(mockT, []int{1, 2}).Not().ToHaveLen(3) &&
+		That(mockT, "").ToBeEmpty() &&
+		That(mockT, "a").Not().ToBeEmpty()
+
+	if !passed {
+		t.Fatalf("expected collection expectations to pass, logs: %v", mockT.Logs)
+	}
+}
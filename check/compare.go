@@ -0,0 +1,351 @@
+package check
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// CompareOption configures how DeepEqual and NotDeepEqual compare values.
+// Options are passed in place of, or alongside, msgAndArgs; they are removed
+// from it before any custom message is formatted.
+//
+//	check.DeepEqual(t, got, want, check.WithCmpOpts(cmpopts.IgnoreFields(User{}, "ID")))
+type CompareOption func(*compareConfig)
+
+// unexportedMode controls how unexported struct fields are compared.
+type unexportedMode int
+
+const (
+	// unexportedDefault leaves unexported fields to cmp, which panics on
+	// them, and so relies on the reflection fallback.
+	unexportedDefault unexportedMode = iota
+	unexportedAllow
+	unexportedIgnore
+)
+
+// compareConfig is the result of applying CompareOptions.
+type compareConfig struct {
+	// cmpOpts are the options given with WithCmpOpts.
+	cmpOpts    []cmp.Option
+	unexported unexportedMode
+	// floatTolerance is the allowed difference between floats, or nil
+	// when floats must be exactly equal. floatOpts are the equivalent cmp
+	// options.
+	floatTolerance *float64
+	floatOpts      []cmp.Option
+}
+
+// WithCmpOpts passes the given go-cmp options through to cmp.Diff.
+// When cmp cannot compare the values, for example because of unexported
+// struct fields, the comparison fails instead of falling back to reflection
+// without these options.
+func WithCmpOpts(opts ...cmp.Option) CompareOption {
+	return func(config *compareConfig) {
+		config.cmpOpts = append(config.cmpOpts, opts...)
+	}
+}
+
+// AllowUnexported compares the unexported fields of every struct type.
+func AllowUnexported() CompareOption {
+	return func(config *compareConfig) {
+		config.unexported = unexportedAllow
+	}
+}
+
+// IgnoreUnexported skips the unexported fields of every struct type.
+func IgnoreUnexported() CompareOption {
+	return func(config *compareConfig) {
+		config.unexported = unexportedIgnore
+	}
+}
+
+// FloatTolerance treats floats anywhere within the compared values as equal
+// when they are within delta of each other, following the rules of InDelta.
+// Like cmpopts.EquateApprox, it panics if delta is negative or NaN.
+func FloatTolerance(delta float64) CompareOption {
+	return func(config *compareConfig) {
+		config.floatOpts = []cmp.Option{cmpopts.EquateApprox(0, delta), cmpopts.EquateNaNs()}
+		config.floatTolerance = &delta
+	}
+}
+
+var defaultCompareOptions struct {
+	mu   sync.RWMutex
+	opts []CompareOption
+}
+
+// SetDefaultCompareOptions replaces the options applied to every deep
+// comparison before the options given to the individual call. It is meant
+// to be called once from TestMain; calling it with no options clears them.
+func SetDefaultCompareOptions(opts ...CompareOption) {
+	defaultCompareOptions.mu.Lock()
+	defer defaultCompareOptions.mu.Unlock()
+
+	defaultCompareOptions.opts = append([]CompareOption(nil), opts...)
+}
+
+// splitOptions separates any options of type O, such as CompareOptions,
+// found in msgAndArgs from the message and its arguments.
+func splitOptions[O any](msgAndArgs []any) ([]O, []any) {
+	var opts []O
+	var rest []any
+	for _, arg := range msgAndArgs {
+		if opt, ok := arg.(O); ok {
+			opts = append(opts, opt)
+		} else {
+			rest = append(rest, arg)
+		}
+	}
+	return opts, rest
+}
+
+// newCompareConfig applies the default options followed by opts.
+func newCompareConfig(opts ...CompareOption) *compareConfig {
+	config := &compareConfig{}
+
+	defaultCompareOptions.mu.RLock()
+	for _, opt := range defaultCompareOptions.opts {
+		opt(config)
+	}
+	defaultCompareOptions.mu.RUnlock()
+
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// compare reports whether expected and actual are deeply equal along with a
+// human-readable report of their differences.
+//
+// Values are compared with cmp.Diff. If cmp panics, most commonly because a
+// struct has unexported fields, the values are compared again with a
+// reflection-based comparer and the report explains why. The reflection
+// comparer cannot apply the options given with WithCmpOpts, so an error is
+// returned instead when there are any.
+func (c *compareConfig) compare(expected, actual any) (equal bool, report string, err error) {
+	diff, cmpPanic := c.cmpDiff(expected, actual)
+	if cmpPanic == nil {
+		return diff == "", diff, nil
+	}
+
+	reason, _, _ := strings.Cut(fmt.Sprint(cmpPanic), "\n")
+	if len(c.cmpOpts) > 0 {
+		return false, "", fmt.Errorf(
+			"cmp could not compare them (%s), and the options given with WithCmpOpts "+
+				"cannot be applied without cmp; use AllowUnexported or IgnoreUnexported, "+
+				"or a cmp option for the unexported fields", reason,
+		)
+	}
+
+	differences := c.reflectDiff(expected, actual)
+	if len(differences) == 0 {
+		return true, "", nil
+	}
+
+	fields := "including"
+	if c.unexported == unexportedIgnore {
+		fields = "ignoring"
+	}
+	return false, fmt.Sprintf(
+		"note: cmp could not compare the values (%s), "+
+			"compared them with reflection %s unexported fields instead\n%s",
+		reason, fields, strings.Join(differences, "\n"),
+	), nil
+}
+
+// cmpDiff runs cmp.Diff with the configured options, recovering any panic.
+func (c *compareConfig) cmpDiff(expected, actual any) (diff string, cmpPanic any) {
+	defer func() {
+		cmpPanic = recover()
+	}()
+
+	opts := append(append([]cmp.Option(nil), c.cmpOpts...), c.floatOpts...)
+	switch c.unexported {
+	case unexportedAllow:
+		opts = append(opts, cmp.Exporter(func(reflect.Type) bool { return true }))
+	case unexportedIgnore:
+		opts = append(opts, cmp.FilterPath(isUnexportedField, cmp.Ignore()))
+	}
+
+	return cmp.Diff(expected, actual, opts...), nil
+}
+
+// isUnexportedField reports whether the path ends at an unexported struct field.
+func isUnexportedField(path cmp.Path) bool {
+	field, ok := path.Last().(cmp.StructField)
+	return ok && !token.IsExported(field.Name())
+}
+
+// reflectDiff compares expected and actual with reflection and returns one
+// "-path: value" / "+path: value" pair per difference. Pointer, map, and
+// slice cycles are detected and treated as equal once revisited, the same
+// way reflect.DeepEqual does.
+func (c *compareConfig) reflectDiff(expected, actual any) []string {
+	walker := &reflectWalker{
+		includeUnexported: c.unexported != unexportedIgnore,
+		floatTolerance:    c.floatTolerance,
+		visited:           map[visit]bool{},
+	}
+	walker.walk(fmt.Sprintf("{%T}", expected), reflect.ValueOf(expected), reflect.ValueOf(actual))
+	return walker.differences
+}
+
+// visit identifies a pair of references that has already been compared.
+type visit struct {
+	expected, actual uintptr
+	typ              reflect.Type
+}
+
+type reflectWalker struct {
+	includeUnexported bool
+	floatTolerance    *float64
+	visited           map[visit]bool
+	differences       []string
+}
+
+func (w *reflectWalker) report(path string, expected, actual reflect.Value) {
+	w.differences = append(w.differences,
+		fmt.Sprintf("-\t%s: %s", path, formatReflectValue(expected)),
+		fmt.Sprintf("+\t%s: %s", path, formatReflectValue(actual)),
+	)
+}
+
+func (w *reflectWalker) walk(path string, expected, actual reflect.Value) {
+	if !expected.IsValid() || !actual.IsValid() {
+		if expected.IsValid() != actual.IsValid() {
+			w.report(path, expected, actual)
+		}
+		return
+	}
+	if expected.Type() != actual.Type() {
+		w.report(path, expected, actual)
+		return
+	}
+
+	switch expected.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				w.report(path, expected, actual)
+			}
+			return
+		}
+		key := visit{expected.Pointer(), actual.Pointer(), expected.Type()}
+		if w.visited[key] {
+			return
+		}
+		w.visited[key] = true
+	}
+
+	switch expected.Kind() {
+	case reflect.Pointer:
+		w.walk(path, expected.Elem(), actual.Elem())
+	case reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				w.report(path, expected, actual)
+			}
+			return
+		}
+		w.walk(path, expected.Elem(), actual.Elem())
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			field := expected.Type().Field(i)
+			if !field.IsExported() && !w.includeUnexported {
+				continue
+			}
+			w.walk(path+"."+field.Name, expected.Field(i), actual.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		common := min(expected.Len(), actual.Len())
+		for i := 0; i < common; i++ {
+			w.walk(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), actual.Index(i))
+		}
+		for i := common; i < expected.Len(); i++ {
+			w.report(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), reflect.Value{})
+		}
+		for i := common; i < actual.Len(); i++ {
+			w.report(fmt.Sprintf("%s[%d]", path, i), reflect.Value{}, actual.Index(i))
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(expected, actual) {
+			w.walk(fmt.Sprintf("%s[%s]", path, formatReflectValue(key)), expected.MapIndex(key), actual.MapIndex(key))
+		}
+	case reflect.Func:
+		if !expected.IsNil() || !actual.IsNil() {
+			w.report(path, expected, actual)
+		}
+	case reflect.Chan, reflect.UnsafePointer:
+		if expected.Pointer() != actual.Pointer() {
+			w.report(path, expected, actual)
+		}
+	case reflect.Bool:
+		if expected.Bool() != actual.Bool() {
+			w.report(path, expected, actual)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if expected.Int() != actual.Int() {
+			w.report(path, expected, actual)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if expected.Uint() != actual.Uint() {
+			w.report(path, expected, actual)
+		}
+	case reflect.Float32, reflect.Float64:
+		equal := expected.Float() == actual.Float()
+		if w.floatTolerance != nil {
+			equal, _ = withinDelta(actual.Float(), expected.Float(), *w.floatTolerance)
+		}
+		if !equal {
+			w.report(path, expected, actual)
+		}
+	case reflect.Complex64, reflect.Complex128:
+		if expected.Complex() != actual.Complex() {
+			w.report(path, expected, actual)
+		}
+	case reflect.String:
+		if expected.String() != actual.String() {
+			w.report(path, expected, actual)
+		}
+	}
+}
+
+// sortedMapKeys returns the union of the keys of two maps of the same type,
+// sorted by their formatted value so reports are deterministic.
+func sortedMapKeys(expected, actual reflect.Value) []reflect.Value {
+	seen := map[string]bool{}
+	var keys []reflect.Value
+	for _, m := range []reflect.Value{expected, actual} {
+		for _, key := range m.MapKeys() {
+			formatted := formatReflectValue(key)
+			if !seen[formatted] {
+				seen[formatted] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return formatReflectValue(keys[i]) < formatReflectValue(keys[j])
+	})
+	return keys
+}
+
+// formatReflectValue formats a value for a difference report. Unlike
+// calling Interface, it works for values read from unexported fields.
+func formatReflectValue(val reflect.Value) string {
+	if !val.IsValid() {
+		return "<missing>"
+	}
+	if val.Kind() == reflect.String {
+		return fmt.Sprintf("%q", val.String())
+	}
+	return fmt.Sprintf("%v", val)
+}
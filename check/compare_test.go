@@ -0,0 +1,256 @@
+package check
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type compareUser struct {
+	ID   int
+	Name string
+}
+
+func TestDeepEqualWithCmpOpts(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	passed := DeepEqual(
+		mockT, compareUser{ID: 1, Name: "Alice"}, compareUser{ID: 2, Name: "Alice"},
+		WithCmpOpts(cmpopts.IgnoreFields(compareUser{}, "ID")),
+	)
+
+	if !passed || mockT.FailCalled {
+		t.Fatalf("DeepEqual should have ignored the ID field, logs: %v", mockT.Logs)
+	}
+}
+
+func TestCompareOptionsAreNotTreatedAsMessages(t *testing.T) {
+	t.Run("Default message", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		DeepEqual(
+			mockT, compareUser{ID: 1, Name: "Alice"}, compareUser{ID: 2, Name: "Bob"},
+			WithCmpOpts(cmpopts.IgnoreFields(compareUser{}, "ID")),
+		)
+
+		if len(mockT.Logs) != 1 || !containsDiffMessage(mockT.Logs[0]) {
+			t.Fatalf("expected the default diff message, got %v", mockT.Logs)
+		}
+		if strings.Contains(mockT.Logs[0], "ID") {
+			t.Errorf("expected the ignored field to be left out of the diff, got %s", mockT.Logs[0])
+		}
+	})
+
+	t.Run("Custom message", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		NotDeepEqual(
+			mockT, compareUser{ID: 1}, compareUser{ID: 2},
+			"users %s", WithCmpOpts(cmpopts.IgnoreFields(compareUser{}, "ID")), "match",
+		)
+
+		if len(mockT.Logs) != 1 || mockT.Logs[0] != "users match" {
+			t.Fatalf("expected the custom message, got %v", mockT.Logs)
+		}
+	})
+}
+
+func TestSetDefaultCompareOptions(t *testing.T) {
+	SetDefaultCompareOptions(WithCmpOpts(cmpopts.IgnoreFields(compareUser{}, "ID")))
+	defer SetDefaultCompareOptions()
+	mockT := &cmtest.MockT{}
+
+	passed := DeepEqual(mockT, compareUser{ID: 1}, compareUser{ID: 2}) &&
+		Matches(mockT, compareUser{ID: 1}, DeepEqualTo(compareUser{ID: 2}))
+
+	if !passed {
+		t.Fatalf("expected the default options to apply, logs: %v", mockT.Logs)
+	}
+
+	SetDefaultCompareOptions()
+	if DeepEqual(mockT, compareUser{ID: 1}, compareUser{ID: 2}) {
+		t.Fatal("expected the default options to be cleared")
+	}
+}
+
+type account struct {
+	Name    string
+	balance int
+}
+
+type node struct {
+	value int
+	next  *node
+}
+
+func TestDeepEqualWithUnexportedFields(t *testing.T) {
+	t.Run("Equal values fall back to reflection", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(mockT, account{"Alice", 10}, account{"Alice", 10})
+
+		if !passed || mockT.FailCalled {
+			t.Fatalf("DeepEqual should have passed, logs: %v", mockT.Logs)
+		}
+	})
+
+	t.Run("Different values explain the fallback", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(mockT, account{"Alice", 10}, account{"Alice", 20})
+
+		if passed || !mockT.FailCalled {
+			t.Fatal("DeepEqual should have failed")
+		}
+		expected := "mismatch (-expected +actual):\n" +
+			"note: cmp could not compare the values (cannot handle unexported field at {check.account}.balance:), " +
+			"compared them with reflection including unexported fields instead\n" +
+			"-\t{check.account}.balance: 20\n" +
+			"+\t{check.account}.balance: 10"
+		if len(mockT.Logs) != 1 || mockT.Logs[0] != expected {
+			t.Errorf("expected log message:\n%s\ngot: %v", expected, mockT.Logs)
+		}
+	})
+
+	t.Run("IgnoreUnexported", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(mockT, account{"Alice", 10}, account{"Alice", 20}, IgnoreUnexported())
+
+		if !passed {
+			t.Fatalf("DeepEqual should have ignored the unexported field, logs: %v", mockT.Logs)
+		}
+	})
+
+	t.Run("Cmp options are not dropped by the fallback", func(t *testing.T) {
+		for _, tc := range []struct {
+			name string
+			fn   func(t *cmtest.MockT) bool
+		}{
+			{"DeepEqual", func(t *cmtest.MockT) bool {
+				return DeepEqual(t, account{"Alice", 10}, account{"Bob", 10}, WithCmpOpts(cmpopts.IgnoreFields(account{}, "Name")))
+			}},
+			{"NotDeepEqual", func(t *cmtest.MockT) bool {
+				return NotDeepEqual(t, account{"Alice", 10}, account{"Bob", 10}, WithCmpOpts(cmpopts.IgnoreFields(account{}, "Name")))
+			}},
+		} {
+			mockT := &cmtest.MockT{}
+
+			if tc.fn(mockT) {
+				t.Fatalf("%s should have failed instead of comparing without the cmp options", tc.name)
+			}
+			expected := "cannot compare the values: cmp could not compare them " +
+				"(cannot handle unexported field at {check.account}.balance:), " +
+				"and the options given with WithCmpOpts cannot be applied without cmp; " +
+				"use AllowUnexported or IgnoreUnexported, or a cmp option for the unexported fields"
+			if len(mockT.Logs) != 1 || mockT.Logs[0] != expected {
+				t.Errorf("%s: expected log message:\n%s\ngot: %v", tc.name, expected, mockT.Logs)
+			}
+		}
+	})
+
+	t.Run("Cmp options with a custom message", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(
+			mockT, account{"Alice", 10}, account{"Bob", 10},
+			WithCmpOpts(cmpopts.IgnoreFields(account{}, "Name")), "accounts differ for %s", "Alice",
+		)
+
+		if passed {
+			t.Fatal("DeepEqual should have failed instead of comparing without the cmp options")
+		}
+		if len(mockT.Logs) != 1 || mockT.Logs[0] != "accounts differ for Alice" {
+			t.Errorf("expected the custom message, got %v", mockT.Logs)
+		}
+	})
+
+	t.Run("Cmp options with IgnoreUnexported", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(
+			mockT, account{"Alice", 10}, account{"Bob", 20},
+			WithCmpOpts(cmpopts.IgnoreFields(account{}, "Name")), IgnoreUnexported(),
+		)
+
+		if !passed {
+			t.Fatalf("DeepEqual should have applied the cmp options, logs: %v", mockT.Logs)
+		}
+	})
+
+	t.Run("AllowUnexported", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(mockT, account{"Alice", 10}, account{"Alice", 20}, AllowUnexported())
+
+		if passed {
+			t.Fatal("DeepEqual should have compared the unexported field")
+		}
+		if len(mockT.Logs) != 1 || strings.Contains(mockT.Logs[0], "note:") ||
+			!strings.Contains(mockT.Logs[0], "balance") {
+			t.Errorf("expected a cmp diff of the unexported field, got %v", mockT.Logs)
+		}
+	})
+
+	t.Run("NotDeepEqual", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		if !NotDeepEqual(mockT, account{"Alice", 10}, account{"Alice", 20}) {
+			t.Fatalf("NotDeepEqual should have passed, logs: %v", mockT.Logs)
+		}
+	})
+}
+
+func TestDeepEqualWithCyclicValues(t *testing.T) {
+	newRing := func(values ...int) *node {
+		head := &node{value: values[0]}
+		current := head
+		for _, value := range values[1:] {
+			current.next = &node{value: value}
+			current = current.next
+		}
+		current.next = head
+		return head
+	}
+
+	t.Run("Equal cycles", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		if !DeepEqual(mockT, newRing(1, 2, 3), newRing(1, 2, 3)) {
+			t.Fatalf("DeepEqual should have passed for equal cycles, logs: %v", mockT.Logs)
+		}
+	})
+
+	t.Run("Different cycles", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		if DeepEqual(mockT, newRing(1, 2, 3), newRing(1, 5, 3)) {
+			t.Fatal("DeepEqual should have failed for different cycles")
+		}
+		if len(mockT.Logs) != 1 || !strings.Contains(mockT.Logs[0], "-\t{*check.node}.next.value: 5") {
+			t.Errorf("expected the differing field in the report, got %v", mockT.Logs)
+		}
+	})
+}
+
+func TestReflectDiffReportsCollections(t *testing.T) {
+	config := newCompareConfig()
+	differences := config.reflectDiff(
+		map[string][]int{"a": {1, 2}, "b": {3}},
+		map[string][]int{"a": {1}, "c": {3}},
+	)
+
+	expected := []string{
+		"-\t{map[string][]int}[\"a\"][1]: 2",
+		"+\t{map[string][]int}[\"a\"][1]: <missing>",
+		"-\t{map[string][]int}[\"b\"]: [3]",
+		"+\t{map[string][]int}[\"b\"]: <missing>",
+		"-\t{map[string][]int}[\"c\"]: <missing>",
+		"+\t{map[string][]int}[\"c\"]: [3]",
+	}
+	if strings.Join(differences, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected differences:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(differences, "\n"))
+	}
+}
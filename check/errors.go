@@ -0,0 +1,139 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// ErrorAs checks whether err's error tree contains an error assignable to T,
+// as reported by errors.As. It returns the matched error so it can be
+// inspected further. On failure it logs the whole error tree along with the
+// dynamic type of each error in it.
+//
+//	if pathErr, ok := check.ErrorAs[*fs.PathError](t, err); ok {
+//		check.Equal(t, pathErr.Op, "open")
+//	}
+func ErrorAs[T error](t checkmate.TestingT, err error, msgAndArgs ...any) (T, bool) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	var target T
+	targetType := reflect.TypeOf((*T)(nil)).Elem()
+	if err == nil {
+		return target, check(t, false, "expected an error of type %v in the error tree, got nil", targetType)
+	}
+
+	matched := errors.As(err, &target)
+	if len(msgAndArgs) == 0 && !matched {
+		msgAndArgs = []any{
+			"expected an error of type %v in the error tree, got:\n%s", targetType, formatErrorTree(err),
+		}
+	}
+
+	return target, check(t, matched, msgAndArgs...)
+}
+
+// NoError checks whether err is nil. On failure it logs the error tree.
+func NoError(t checkmate.TestingT, err error, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 && err != nil {
+		msgAndArgs = []any{"expected no error, got:\n%s", formatErrorTree(err)}
+	}
+
+	return check(t, err == nil, msgAndArgs...)
+}
+
+// Error checks whether err is not nil, i.e. that any error occurred.
+func Error(t checkmate.TestingT, err error, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected an error, got nil"}
+	}
+
+	return check(t, err != nil, msgAndArgs...)
+}
+
+// ErrorEqualsString checks whether err.Error() is exactly errText. A nil
+// err fails the check.
+func ErrorEqualsString(t checkmate.TestingT, err error, errText string, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if err == nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"expected error %q, got nil error", errText}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected error %q, got %q", errText, err.Error()}
+	}
+
+	return check(t, err.Error() == errText, msgAndArgs...)
+}
+
+// ErrorMatches checks whether err.Error() matches the regular expression
+// pattern. A nil err fails the check.
+func ErrorMatches(t checkmate.TestingT, err error, pattern string, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	re, compileErr := regexp.Compile(pattern)
+	if compileErr != nil {
+		return check(t, false, "invalid pattern %q: %v", pattern, compileErr)
+	}
+
+	if err == nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"expected error matching %q, got nil error", pattern}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected error matching %q, got %q", pattern, err.Error()}
+	}
+
+	return check(t, re.MatchString(err.Error()), msgAndArgs...)
+}
+
+// formatErrorTree renders err and every error it wraps, one per line and
+// indented by depth, along with each error's dynamic type.
+func formatErrorTree(err error) string {
+	var tree strings.Builder
+	writeErrorTree(&tree, err, 1)
+	return strings.TrimSuffix(tree.String(), "\n")
+}
+
+func writeErrorTree(tree *strings.Builder, err error, depth int) {
+	message := strings.ReplaceAll(err.Error(), "\n", `\n`)
+	fmt.Fprintf(tree, "%s%T: %s\n", strings.Repeat("  ", depth), err, message)
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := wrapped.Unwrap(); inner != nil {
+			writeErrorTree(tree, inner, depth+1)
+		}
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapped.Unwrap() {
+			if inner != nil {
+				writeErrorTree(tree, inner, depth+1)
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+type codeError struct {
+	Code int
+}
+
+func (e *codeError) Error() string {
+	return fmt.Sprintf("code %d", e.Code)
+}
+
+func TestErrorAsReturnsMatchedError(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	err := fmt.Errorf("loading config: %w", &fs.PathError{Op: "open", Path: "config.yml", Err: os.ErrNotExist})
+
+	pathErr, passed := ErrorAs[*fs.PathError](mockT, err)
+
+	if !passed || mockT.FailCalled {
+		t.Fatalf("ErrorAs should have passed, logs: %v", mockT.Logs)
+	}
+	if pathErr == nil || pathErr.Path != "config.yml" {
+		t.Errorf("expected the matched *fs.PathError to be returned, got %#v", pathErr)
+	}
+}
+
+func TestErrorAsMatchesInterfaces(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	err := fmt.Errorf("wrapped: %w", &codeError{Code: 7})
+
+	coded, passed := ErrorAs[interface{ error }](mockT, err)
+
+	if !passed || coded == nil {
+		t.Fatalf("ErrorAs should have matched the interface type, logs: %v", mockT.Logs)
+	}
+}
+
+func TestErrorAsLogsErrorTree(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	err := fmt.Errorf("request failed: %w", errors.Join(os.ErrClosed, &codeError{Code: 7}))
+
+	target, passed := ErrorAs[*fs.PathError](mockT, err)
+
+	if passed || !mockT.FailCalled {
+		t.Fatal("ErrorAs should have failed")
+	}
+	if target != nil {
+		t.Errorf("expected the zero value on failure, got %#v", target)
+	}
+	expected := "expected an error of type *fs.PathError in the error tree, got:\n" +
+		`  *fmt.wrapError: request failed: file already closed\ncode 7` + "\n" +
+		`    *errors.joinError: file already closed\ncode 7` + "\n" +
+		"      *errors.errorString: file already closed\n" +
+		"      *check.codeError: code 7"
+	if len(mockT.Logs) != 1 || mockT.Logs[0] != expected {
+		t.Errorf("expected log message:\n%s\ngot: %v", expected, mockT.Logs)
+	}
+}
+
+func TestErrorAsWithNilError(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	_, passed := ErrorAs[*codeError](mockT, nil)
+
+	if passed {
+		t.Fatal("ErrorAs should have failed for a nil error")
+	}
+	expected := "expected an error of type *check.codeError in the error tree, got nil"
+	if len(mockT.Logs) != 1 || mockT.Logs[0] != expected {
+		t.Errorf("expected log message '%s', got %v", expected, mockT.Logs)
+	}
+}
+
+func TestErrorChecksAreNilSafe(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t *cmtest.MockT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{
+			"ErrorContains",
+			func(t *cmtest.MockT) bool { return ErrorContains(t, nil, "boom") },
+			false, "expected err to contain boom, got nil error",
+		},
+		{
+			"NotErrorContains",
+			func(t *cmtest.MockT) bool { return NotErrorContains(t, nil, "boom") },
+			true, "",
+		},
+		{
+			"ErrorIs",
+			func(t *cmtest.MockT) bool { return ErrorIs(t, nil, os.ErrClosed) },
+			false, "expected error file already closed in the error tree, got nil",
+		},
+		{
+			"NotErrorIs",
+			func(t *cmtest.MockT) bool { return NotErrorIs(t, nil, os.ErrClosed) },
+			true, "",
+		},
+		{
+			"ErrorEqualsString",
+			func(t *cmtest.MockT) bool { return ErrorEqualsString(t, nil, "boom") },
+			false, `expected error "boom", got nil error`,
+		},
+		{
+			"ErrorMatches",
+			func(t *cmtest.MockT) bool { return ErrorMatches(t, nil, "boom") },
+			false, `expected error matching "boom", got nil error`,
+		},
+		{
+			"Error",
+			func(t *cmtest.MockT) bool { return Error(t, nil) },
+			false, "expected an error, got nil",
+		},
+		{
+			"NoError",
+			func(t *cmtest.MockT) bool { return NoError(t, nil) },
+			true, "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass {
+				t.Fatalf("%s: returned %v, want %v", tc.name, passed, tc.shouldPass)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestErrorCheckMessages(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t *cmtest.MockT) bool
+		logMessage string
+	}{
+		{
+			"NoError logs the error tree",
+			func(t *cmtest.MockT) bool { return NoError(t, fmt.Errorf("saving: %w", os.ErrClosed)) },
+			"expected no error, got:\n" +
+				"  *fmt.wrapError: saving: file already closed\n" +
+				"    *errors.errorString: file already closed",
+		},
+		{
+			"ErrorEqualsString",
+			func(t *cmtest.MockT) bool { return ErrorEqualsString(t, errors.New("boom!"), "boom") },
+			`expected error "boom", got "boom!"`,
+		},
+		{
+			"ErrorMatches",
+			func(t *cmtest.MockT) bool { return ErrorMatches(t, errors.New("code x"), `^code \d+$`) },
+			`expected error matching "^code \\d+$", got "code x"`,
+		},
+		{
+			"ErrorMatches invalid pattern",
+			func(t *cmtest.MockT) bool { return ErrorMatches(t, errors.New("code x"), `(`) },
+			"invalid pattern \"(\": error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			if tc.fn(mockT) {
+				t.Fatalf("%s: expected the check to fail", tc.name)
+			}
+			if len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
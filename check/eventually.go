@@ -0,0 +1,268 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Eventually checks whether condition returns true within timeout. The
+// condition is called immediately and then once per interval.
+func Eventually(t checkmate.TestingT, condition func() bool, timeout, interval time.Duration, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return EventuallyContext(context.Background(), t, condition, timeout, interval, msgAndArgs...)
+}
+
+// EventuallyContext is like Eventually, but also stops polling and fails
+// once ctx is done.
+func EventuallyContext(
+	ctx context.Context, t checkmate.TestingT, condition func() bool,
+	timeout, interval time.Duration, msgAndArgs ...any,
+) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if err := validatePolling(timeout, interval); err != nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"cannot poll: %v", err}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
+	result := poll(ctx, condition, true, timeout, interval)
+	if len(msgAndArgs) == 0 {
+		if result.err != nil {
+			msgAndArgs = []any{
+				"condition was not satisfied before the context was done: %v (%d attempts)",
+				result.err, result.attempts,
+			}
+		} else {
+			msgAndArgs = []any{"condition was not satisfied within %v (%d attempts)", timeout, result.attempts}
+		}
+	}
+
+	return check(t, result.reached, msgAndArgs...)
+}
+
+// Consistently checks whether condition keeps returning true for the whole
+// duration. The condition is called immediately and then once per interval.
+func Consistently(t checkmate.TestingT, condition func() bool, duration, interval time.Duration, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return ConsistentlyContext(context.Background(), t, condition, duration, interval, msgAndArgs...)
+}
+
+// ConsistentlyContext is like Consistently, but fails if ctx is done before
+// the duration has elapsed.
+func ConsistentlyContext(
+	ctx context.Context, t checkmate.TestingT, condition func() bool,
+	duration, interval time.Duration, msgAndArgs ...any,
+) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if err := validatePolling(duration, interval); err != nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"cannot poll: %v", err}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
+	result := poll(ctx, condition, false, duration, interval)
+	if len(msgAndArgs) == 0 {
+		if result.err != nil {
+			msgAndArgs = []any{
+				"context was done before the condition held for %v: %v (%d attempts)",
+				duration, result.err, result.attempts,
+			}
+		} else {
+			msgAndArgs = []any{
+				"expected condition to hold for %v, but it failed after %v on attempt %d",
+				duration, result.elapsed, result.attempts,
+			}
+		}
+	}
+
+	return check(t, !result.reached && result.err == nil, msgAndArgs...)
+}
+
+// Never checks whether condition keeps returning false for the whole
+// duration. The condition is called immediately and then once per interval.
+func Never(t checkmate.TestingT, condition func() bool, duration, interval time.Duration, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return NeverContext(context.Background(), t, condition, duration, interval, msgAndArgs...)
+}
+
+// NeverContext is like Never, but fails if ctx is done before the duration
+// has elapsed.
+func NeverContext(
+	ctx context.Context, t checkmate.TestingT, condition func() bool,
+	duration, interval time.Duration, msgAndArgs ...any,
+) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if err := validatePolling(duration, interval); err != nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"cannot poll: %v", err}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
+	result := poll(ctx, condition, true, duration, interval)
+	if len(msgAndArgs) == 0 {
+		if result.err != nil {
+			msgAndArgs = []any{
+				"context was done before the condition was unsatisfied for %v: %v (%d attempts)",
+				duration, result.err, result.attempts,
+			}
+		} else {
+			msgAndArgs = []any{
+				"expected condition to never be satisfied within %v, but it was after %v on attempt %d",
+				duration, result.elapsed, result.attempts,
+			}
+		}
+	}
+
+	return check(t, !result.reached && result.err == nil, msgAndArgs...)
+}
+
+// EventuallyWithT checks whether fn completes without failing within
+// timeout. Each attempt gets its own checkmate.TestingT, so the usual check
+// and assert functions can be used inside fn; an assert failure only ends
+// the current attempt. If no attempt succeeds, the failures logged by the
+// last attempt are reported.
+func EventuallyWithT(
+	t checkmate.TestingT, fn func(t checkmate.TestingT),
+	timeout, interval time.Duration, msgAndArgs ...any,
+) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return EventuallyWithTContext(context.Background(), t, fn, timeout, interval, msgAndArgs...)
+}
+
+// EventuallyWithTContext is like EventuallyWithT, but also stops polling and
+// fails once ctx is done.
+func EventuallyWithTContext(
+	ctx context.Context, t checkmate.TestingT, fn func(t checkmate.TestingT),
+	timeout, interval time.Duration, msgAndArgs ...any,
+) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if err := validatePolling(timeout, interval); err != nil {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"cannot poll: %v", err}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
+	var lastAttempt *collectT
+	condition := func() bool {
+		lastAttempt = collect(fn)
+		return !lastAttempt.Failed()
+	}
+
+	result := poll(ctx, condition, true, timeout, interval)
+	if len(msgAndArgs) == 0 {
+		var lastFailures string
+		if lastAttempt != nil {
+			lastFailures = indent(strings.Join(lastAttempt.Logs(), "\n"))
+		}
+		if result.err != nil {
+			msgAndArgs = []any{
+				"condition was not satisfied before the context was done: %v (%d attempts), last attempt failures:\n%s",
+				result.err, result.attempts, lastFailures,
+			}
+		} else {
+			msgAndArgs = []any{
+				"condition was not satisfied within %v (%d attempts), last attempt failures:\n%s",
+				timeout, result.attempts, lastFailures,
+			}
+		}
+	}
+
+	return check(t, result.reached, msgAndArgs...)
+}
+
+// pollResult describes how a call to poll finished.
+type pollResult struct {
+	// reached is true when the condition returned the wanted value.
+	reached bool
+	// err is the context's error when polling stopped because ctx was done.
+	err      error
+	attempts int
+	elapsed  time.Duration
+}
+
+// validatePolling reports durations which poll cannot work with.
+func validatePolling(duration, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %v", interval)
+	}
+	if duration < 0 {
+		return fmt.Errorf("duration must not be negative, got %v", duration)
+	}
+	return nil
+}
+
+// poll calls condition immediately and then once per interval until it
+// returns want, the duration elapses, or ctx is done. No attempt is started
+// once the duration has elapsed.
+func poll(ctx context.Context, condition func() bool, want bool, duration, interval time.Duration) pollResult {
+	start := time.Now()
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	result := pollResult{}
+	for {
+		if err := ctx.Err(); err != nil {
+			result.err = err
+			result.elapsed = time.Since(start)
+			return result
+		}
+
+		result.attempts++
+		if condition() == want {
+			result.reached = true
+			result.elapsed = time.Since(start)
+			return result
+		}
+
+		// A slow condition can outlast the duration while the timer and the
+		// ticker both fire, and select would pick between them at random.
+		if result.elapsed = time.Since(start); result.elapsed >= duration {
+			return result
+		}
+
+		select {
+		case <-ctx.Done():
+			result.err = ctx.Err()
+			result.elapsed = time.Since(start)
+			return result
+		case <-timer.C:
+			result.elapsed = time.Since(start)
+			return result
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package check
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestEventuallyRetriesUntilConditionHolds(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	var calls atomic.Int32
+
+	passed := Eventually(mockT, func() bool {
+		return calls.Add(1) >= 3
+	}, time.Second, time.Millisecond)
+
+	if !passed || mockT.FailCalled {
+		t.Fatalf("Eventually should have passed, logs: %v", mockT.Logs)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected the condition to be called 3 times, got %d", calls.Load())
+	}
+}
+
+func TestEventuallyReportsTimeout(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	passed := Eventually(mockT, func() bool { return false }, 20*time.Millisecond, 5*time.Millisecond)
+
+	if passed || !mockT.FailCalled {
+		t.Fatal("Eventually should have failed")
+	}
+	if len(mockT.Logs) != 1 || !strings.HasPrefix(mockT.Logs[0], "condition was not satisfied within 20ms (") {
+		t.Errorf("unexpected logs: %v", mockT.Logs)
+	}
+}
+
+func TestEventuallyContextStopsWhenCanceled(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	passed := EventuallyContext(ctx, mockT, func() bool { return false }, time.Minute, time.Millisecond)
+
+	if passed {
+		t.Fatal("EventuallyContext should have failed")
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("EventuallyContext kept polling after the context was canceled")
+	}
+	expected := "condition was not satisfied before the context was done: context canceled (0 attempts)"
+	if len(mockT.Logs) != 1 || mockT.Logs[0] != expected {
+		t.Errorf("expected log message '%s', got %v", expected, mockT.Logs)
+	}
+}
+
+func TestConsistentlyFailsWhenConditionStopsHolding(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	var calls atomic.Int32
+
+	passed := Consistently(mockT, func() bool {
+		return calls.Add(1) < 3
+	}, time.Second, time.Millisecond)
+
+	if passed {
+		t.Fatal("Consistently should have failed")
+	}
+	prefix := "expected condition to hold for 1s, but it failed after "
+	if len(mockT.Logs) != 1 || !strings.HasPrefix(mockT.Logs[0], prefix) ||
+		!strings.HasSuffix(mockT.Logs[0], "on attempt 3") {
+		t.Errorf("unexpected logs: %v", mockT.Logs)
+	}
+}
+
+func TestConsistentlyContextFailsWhenCanceled(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	passed := ConsistentlyContext(ctx, mockT, func() bool { return true }, time.Minute, time.Millisecond)
+
+	if passed {
+		t.Fatal("ConsistentlyContext should have failed when the context finished first")
+	}
+}
+
+func TestNeverFailsWhenConditionBecomesTrue(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	var calls atomic.Int32
+
+	passed := Never(mockT, func() bool {
+		return calls.Add(1) == 2
+	}, time.Second, time.Millisecond)
+
+	if passed {
+		t.Fatal("Never should have failed")
+	}
+	if len(mockT.Logs) != 1 || !strings.HasSuffix(mockT.Logs[0], "on attempt 2") {
+		t.Errorf("unexpected logs: %v", mockT.Logs)
+	}
+}
+
+func TestEventuallyWithTRetriesChecks(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	var calls atomic.Int32
+
+	passed := EventuallyWithT(mockT, func(c checkmate.TestingT) {
+		Equal(c, int(calls.Add(1)), 3)
+	}, time.Second, time.Millisecond)
+
+	if !passed || mockT.FailCalled || len(mockT.Logs) > 0 {
+		t.Fatalf("EventuallyWithT should have passed without logs, got %v", mockT.Logs)
+	}
+}
+
+func TestEventuallyWithTReportsLastAttemptFailures(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	var calls atomic.Int32
+
+	passed := EventuallyWithT(mockT, func(c checkmate.TestingT) {
+		attempt := calls.Add(1)
+		if attempt >= 2 {
+			c.Log("giving up")
+			c.FailNow()
+		}
+		Equal(c, attempt, int32(0))
+		c.Log("not reached after FailNow")
+	}, 20*time.Millisecond, 5*time.Millisecond)
+
+	if passed || !mockT.FailCalled {
+		t.Fatal("EventuallyWithT should have failed")
+	}
+	if len(mockT.Logs) != 1 {
+		t.Fatalf("expected a single log message, got %v", mockT.Logs)
+	}
+	if !strings.HasPrefix(mockT.Logs[0], "condition was not satisfied within 20ms (") ||
+		!strings.HasSuffix(mockT.Logs[0], "last attempt failures:\n  giving up") {
+		t.Errorf("unexpected log message: %s", mockT.Logs[0])
+	}
+}
+
+func TestEventuallyWithTPropagatesPanics(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	passed := PanicsWithValue(mockT, func() {
+		EventuallyWithT(mockT, func(c checkmate.TestingT) {
+			panic("boom")
+		}, time.Second, time.Millisecond)
+	}, "boom")
+
+	if !passed {
+		t.Fatalf("expected the panic to reach the caller, logs: %v", mockT.Logs)
+	}
+}
+
+func TestPollingRejectsInvalidDurations(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		logMessage string
+	}{
+		{"Eventually zero interval", func(t checkmate.TestingT) bool {
+			return Eventually(t, func() bool { return true }, time.Second, 0)
+		}, "cannot poll: interval must be positive, got 0s"},
+		{"Consistently negative interval", func(t checkmate.TestingT) bool {
+			return Consistently(t, func() bool { return true }, time.Second, -time.Millisecond)
+		}, "cannot poll: interval must be positive, got -1ms"},
+		{"Never negative duration", func(t checkmate.TestingT) bool {
+			return Never(t, func() bool { return false }, -time.Second, time.Millisecond)
+		}, "cannot poll: duration must not be negative, got -1s"},
+		{"EventuallyWithT zero interval", func(t checkmate.TestingT) bool {
+			return EventuallyWithT(t, func(t checkmate.TestingT) {}, time.Second, 0)
+		}, "cannot poll: interval must be positive, got 0s"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			if tc.fn(mockT) || !mockT.FailCalled {
+				t.Fatalf("%s: expected the check to fail", tc.name)
+			}
+			if len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestEventuallyStopsAtTheDeadlineWithASlowCondition(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	var calls atomic.Int32
+
+	start := time.Now()
+	passed := Eventually(mockT, func() bool {
+		calls.Add(1)
+		time.Sleep(30 * time.Millisecond)
+		return false
+	}, 10*time.Millisecond, time.Millisecond)
+
+	if passed {
+		t.Fatal("Eventually should have failed")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected no attempt after the deadline, got %d attempts", calls.Load())
+	}
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Errorf("expected Eventually to stop after the first attempt, took %v", elapsed)
+	}
+}
@@ -0,0 +1,156 @@
+package check
+
+import (
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Expectation is a chainable builder around a single value. Every terminal
+// method routes through the matching check function, so the failure messages
+// are the same ones the functions produce when called directly.
+type Expectation struct {
+	t       checkmate.TestingT
+	actual  any
+	negated bool
+}
+
+// That starts an expectation on value. Terminal methods such as ToEqual
+// mark the test as failed and return false when the expectation does not
+// hold, just like the other functions in this package.
+//
+//	check.That(t, got).ToEqual(5)
+//	check.That(t, err).Not().ToBeNil()
+func That(t checkmate.TestingT, value any) *Expectation {
+	return &Expectation{t: t, actual: value}
+}
+
+// Not returns a copy of the expectation whose terminal method is negated.
+func (e *Expectation) Not() *Expectation {
+	return &Expectation{t: e.t, actual: e.actual, negated: !e.negated}
+}
+
+// ToEqual checks the value against expected with Equal, or NotEqual when negated.
+func (e *Expectation) ToEqual(expected any, msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if e.negated {
+		return NotEqual(e.t, e.actual, expected, msgAndArgs...)
+	}
+	return Equal(e.t, e.actual, expected, msgAndArgs...)
+}
+
+// ToDeepEqual checks the value against expected with DeepEqual, or
+// NotDeepEqual when negated.
+func (e *Expectation) ToDeepEqual(expected any, msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if e.negated {
+		return NotDeepEqual(e.t, e.actual, expected, msgAndArgs...)
+	}
+	return DeepEqual(e.t, e.actual, expected, msgAndArgs...)
+}
+
+// ToBeNil checks the value with Nil, or NotNil when negated.
+func (e *Expectation) ToBeNil(msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if e.negated {
+		return NotNil(e.t, e.actual, msgAndArgs...)
+	}
+	return Nil(e.t, e.actual, msgAndArgs...)
+}
+
+// ToBeTrue checks the value with True, or False when negated. The value
+// must be a bool.
+func (e *Expectation) ToBeTrue(msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	condition, isBool := e.actual.(bool)
+	if !isBool {
+		return check(e.t, false, "expected a bool value, got %T", e.actual)
+	}
+
+	if e.negated {
+		return False(e.t, condition, msgAndArgs...)
+	}
+	return True(e.t, condition, msgAndArgs...)
+}
+
+// ToBeFalse checks the value with False, or True when negated. The value
+// must be a bool.
+func (e *Expectation) ToBeFalse(msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return e.Not().ToBeTrue(msgAndArgs...)
+}
+
+// ToMatchError checks the value with ErrorIs, or NotErrorIs when negated.
+// The value must be an error or nil.
+func (e *Expectation) ToMatchError(target error, msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	err, isErr := e.actual.(error)
+	if !isErr && e.actual != nil {
+		return check(e.t, false, "expected an error value, got %T", e.actual)
+	}
+
+	if e.negated {
+		return NotErrorIs(e.t, err, target, msgAndArgs...)
+	}
+	return ErrorIs(e.t, err, target, msgAndArgs...)
+}
+
+// ToContain checks the value with Contains, or NotContains when negated.
+func (e *Expectation) ToContain(element any, msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if e.negated {
+		return NotContains(e.t, e.actual, element, msgAndArgs...)
+	}
+	return Contains(e.t, e.actual, element, msgAndArgs...)
+}
+
+// ToBeEmpty checks the value with Empty, or NotEmpty when negated.
+func (e *Expectation) ToBeEmpty(msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if e.negated {
+		return NotEmpty(e.t, e.actual, msgAndArgs...)
+	}
+	return Empty(e.t, e.actual, msgAndArgs...)
+}
+
+// ToHaveLen checks the value with Len. When negated, it checks that the
+// value has any length other than the given one.
+func (e *Expectation) ToHaveLen(length int, msgAndArgs ...any) bool {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if e.negated {
+		actualLength, ok := lengthOf(e.actual)
+		if !ok {
+			return check(e.t, false, "cannot get the length of %T", e.actual)
+		}
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"expected %v to not have length %d", e.actual, length}
+		}
+		return check(e.t, actualLength != length, msgAndArgs...)
+	}
+	return Len(e.t, e.actual, length, msgAndArgs...)
+}
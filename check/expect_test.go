@@ -0,0 +1,189 @@
+package check
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestExpectationMatchesCheckFunctions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fluent     func(t checkmate.TestingT) bool
+		direct     func(t checkmate.TestingT) bool
+		shouldPass bool
+	}{
+		{
+			"ToEqual passing",
+			func(t checkmate.TestingT) bool { return That(t, 5).ToEqual(5) },
+			func(t checkmate.TestingT) bool { return Equal(t, 5, 5) },
+			true,
+		},
+		{
+			"ToEqual failing",
+			func(t checkmate.TestingT) bool { return That(t, 5).ToEqual(10) },
+			func(t checkmate.TestingT) bool { return Equal(t, 5, 10) },
+			false,
+		},
+		{
+			"Not ToEqual failing",
+			func(t checkmate.TestingT) bool { return That(t, 5).Not().ToEqual(5) },
+			func(t checkmate.TestingT) bool { return NotEqual(t, 5, 5) },
+			false,
+		},
+		{
+			"ToDeepEqual failing",
+			func(t checkmate.TestingT) bool { return That(t, []int{1}).ToDeepEqual([]int{2}) },
+			func(t checkmate.TestingT) bool { return DeepEqual(t, []int{1}, []int{2}) },
+			false,
+		},
+		{
+			"Not ToDeepEqual failing",
+			func(t checkmate.TestingT) bool { return That(t, []int{1}).Not().ToDeepEqual([]int{1}) },
+			func(t checkmate.TestingT) bool { return NotDeepEqual(t, []int{1}, []int{1}) },
+			false,
+		},
+		{
+			"ToBeNil failing",
+			func(t checkmate.TestingT) bool { return That(t, 1).ToBeNil() },
+			func(t checkmate.TestingT) bool { return Nil(t, 1) },
+			false,
+		},
+		{
+			"Not ToBeNil failing",
+			func(t checkmate.TestingT) bool { return That(t, nil).Not().ToBeNil() },
+			func(t checkmate.TestingT) bool { return NotNil(t, nil) },
+			false,
+		},
+		{
+			"ToBeTrue failing",
+			func(t checkmate.TestingT) bool { return That(t, false).ToBeTrue() },
+			func(t checkmate.TestingT) bool { return True(t, false) },
+			false,
+		},
+		{
+			"ToBeFalse failing",
+			func(t checkmate.TestingT) bool { return That(t, true).ToBeFalse() },
+			func(t checkmate.TestingT) bool { return False(t, true) },
+			false,
+		},
+		{
+			"ToMatchError failing",
+			func(t checkmate.TestingT) bool { return That(t, os.ErrClosed).ToMatchError(os.ErrExist) },
+			func(t checkmate.TestingT) bool { return ErrorIs(t, os.ErrClosed, os.ErrExist) },
+			false,
+		},
+		{
+			"Not ToMatchError failing",
+			func(t checkmate.TestingT) bool { return That(t, os.ErrClosed).Not().ToMatchError(os.ErrClosed) },
+			func(t checkmate.TestingT) bool { return NotErrorIs(t, os.ErrClosed, os.ErrClosed) },
+			false,
+		},
+		{
+			"Custom message",
+			func(t checkmate.TestingT) bool { return That(t, 5).ToEqual(10, "my message: %d", 5) },
+			func(t checkmate.TestingT) bool { return Equal(t, 5, 10, "my message: %d", 5) },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fluentT := &cmtest.MockT{}
+			directT := &cmtest.MockT{}
+
+			fluentPassed := tc.fluent(fluentT)
+			directPassed := tc.direct(directT)
+
+			if fluentPassed != tc.shouldPass || directPassed != tc.shouldPass {
+				t.Fatalf(
+					"%s: fluent returned %v, direct returned %v, want %v",
+					tc.name, fluentPassed, directPassed, tc.shouldPass,
+				)
+			}
+			if fluentT.FailCalled != directT.FailCalled {
+				t.Errorf("%s: FailCalled = %v, want %v", tc.name, fluentT.FailCalled, directT.FailCalled)
+			}
+			if len(fluentT.Logs) != len(directT.Logs) {
+				t.Fatalf("%s: expected logs %v, got %v", tc.name, directT.Logs, fluentT.Logs)
+			}
+			for i := range directT.Logs {
+				if fluentT.Logs[i] != directT.Logs[i] {
+					t.Errorf("%s: expected log message '%s', got '%s'", tc.name, directT.Logs[i], fluentT.Logs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestExpectationToContain(t *testing.T) {
+	testCases := []struct {
+		name       string
+		actual     any
+		element    any
+		negated    bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"Substring", "hello world", "world", false, true, ""},
+		{"Missing substring", "hello world", "moon", false, false, "expected hello world to contain moon"},
+		{"Slice item", []int{1, 2, 3}, 2, false, true, ""},
+		{"Map key", map[string]int{"a": 1}, "a", false, true, ""},
+		{"Not contains", []int{1, 2, 3}, 2, true, false, "expected [1 2 3] to not contain 2"},
+		{"Unsupported type", 5, 5, false, false, "cannot check whether int contains 5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+			expectation := That(mockT, tc.actual)
+			if tc.negated {
+				expectation = expectation.Not()
+			}
+
+			passed := expectation.ToContain(tc.element)
+
+			if passed != tc.shouldPass {
+				t.Fatalf("%s: ToContain returned %v, want %v", tc.name, passed, tc.shouldPass)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestExpectationRejectsWrongValueTypes(t *testing.T) {
+	t.Run("ToBeTrue on non-bool", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+		if That(mockT, 1).ToBeTrue() {
+			t.Fatal("ToBeTrue should have failed for a non-bool value")
+		}
+		if len(mockT.Logs) != 1 || mockT.Logs[0] != "expected a bool value, got int" {
+			t.Errorf("unexpected logs: %v", mockT.Logs)
+		}
+	})
+
+	t.Run("ToMatchError on non-error", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+		if That(mockT, "boom").ToMatchError(errors.New("boom")) {
+			t.Fatal("ToMatchError should have failed for a non-error value")
+		}
+		if len(mockT.Logs) != 1 || mockT.Logs[0] != "expected an error value, got string" {
+			t.Errorf("unexpected logs: %v", mockT.Logs)
+		}
+	})
+}
+
+func TestExpectationCallsHelper(t *testing.T) {
+	mockHelperT := &cmtest.MockHelperT{}
+
+	That(mockHelperT, 5).ToEqual(5)
+
+	if !mockHelperT.HelperCalled {
+		t.Error("expected HelperT to be called")
+	}
+}
@@ -0,0 +1,284 @@
+package check
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/update"
+)
+
+// defaultGoldenDir is the directory golden files are kept in unless
+// GoldenDir says otherwise.
+const defaultGoldenDir = "testdata"
+
+// GoldenOption configures Golden. Like CompareOption, options are passed in
+// place of, or alongside, msgAndArgs.
+type GoldenOption func(*goldenConfig)
+
+// goldenConfig is the result of applying GoldenOptions.
+type goldenConfig struct {
+	dir    string
+	json   bool
+	binary bool
+}
+
+// GoldenDir keeps golden files under dir instead of testdata.
+func GoldenDir(dir string) GoldenOption {
+	return func(config *goldenConfig) {
+		config.dir = dir
+	}
+}
+
+// GoldenJSON treats the actual value and the golden file as JSON documents.
+// Both are re-indented with sorted object keys before they are compared, so
+// formatting and key order do not matter, and updated files are written in
+// the same normalized form.
+func GoldenJSON() GoldenOption {
+	return func(config *goldenConfig) {
+		config.json = true
+	}
+}
+
+// GoldenBinary compares the golden file byte for byte and reports failures
+// as a diff of hex dumps. Files which are not valid UTF-8 or which contain a NUL byte
+// are treated as binary without this option.
+func GoldenBinary() GoldenOption {
+	return func(config *goldenConfig) {
+		config.binary = true
+	}
+}
+
+// goldenFiles records which golden files were used while the tests ran so
+// VerifyGoldenFiles can find the orphaned ones.
+var goldenFiles = struct {
+	mu    sync.Mutex
+	used  map[string]bool
+	roots map[string]bool
+}{
+	used:  map[string]bool{},
+	roots: map[string]bool{defaultGoldenDir: true},
+}
+
+// Golden checks whether actual matches the golden file
+// testdata/<TestName>/<name>.golden, where TestName is the name of the
+// running test, including any subtests. Differences are reported with the
+// same diff as DeepEqual.
+//
+// When the tests are run with the -checkmate.update flag, or the
+// CHECKMATE_UPDATE environment variable is true, the golden file is written
+// with actual instead and the check passes.
+//
+// t must have a Name method, as *testing.T does.
+func Golden(t checkmate.TestingT, name string, actual []byte, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	nt, ok := t.(namedT)
+	if !ok {
+		return check(t, false, "cannot use golden files, %T does not have a Name method", t)
+	}
+
+	opts, msgAndArgs := splitOptions[GoldenOption](msgAndArgs)
+	config := &goldenConfig{dir: defaultGoldenDir}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	path := filepath.Join(config.dir, filepath.FromSlash(nt.Name()), name+".golden")
+	markGoldenFileUsed(config.dir, path)
+
+	if config.json {
+		normalized, err := normalizeJSON(actual)
+		if err != nil {
+			return check(t, false, "cannot normalize actual value as JSON: %v", err)
+		}
+		actual = normalized
+	}
+
+	if update.Enabled() {
+		if err := writeGoldenFile(path, actual); err != nil {
+			return check(t, false, "cannot update golden file %s: %v", path, err)
+		}
+		t.Log(fmt.Sprintf("checkmate: updated golden file %s", path))
+		return true
+	}
+
+	expected, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return check(
+			t, false,
+			"golden file %s does not exist, run the tests with -checkmate.update or %s=1 to create it",
+			path, update.EnvVar,
+		)
+	} else if err != nil {
+		return check(t, false, "cannot read golden file %s: %v", path, err)
+	}
+
+	if config.json {
+		expected, err = normalizeJSON(expected)
+		if err != nil {
+			return check(t, false, "cannot normalize golden file %s as JSON: %v", path, err)
+		}
+	}
+
+	var equal bool
+	var diff string
+	if config.binary || isBinary(expected) || isBinary(actual) {
+		equal = bytes.Equal(expected, actual)
+		if !equal {
+			diff = unifiedDiff(hexDump(expected), hexDump(actual))
+		}
+	} else {
+		equal, diff, err = newCompareConfig().compare(string(expected), string(actual))
+		if err != nil {
+			return check(t, false, "cannot compare golden file %s: %v", path, err)
+		}
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"golden file %s mismatch (-expected +actual):\n%s", path, diff}
+	}
+
+	return check(t, equal, msgAndArgs...)
+}
+
+// VerifyGoldenFiles runs the tests and then reports golden files which no
+// test used, failing the run if there are any. The files are never removed,
+// since a test may have been skipped rather than deleted. Nothing is
+// reported when the tests fail or only some of them were run, because of
+// -run, -skip, or -short.
+//
+// It returns the exit code to pass to os.Exit from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(check.VerifyGoldenFiles(m))
+//	}
+func VerifyGoldenFiles(m checkmate.M) int {
+	code := m.Run()
+	if code != 0 || testsFiltered() {
+		return code
+	}
+
+	orphans, err := orphanedGoldenFiles()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "checkmate: cannot look for orphaned golden files: %v\n", err)
+		return 1
+	}
+
+	return reportOrphans(os.Stderr, "golden files", orphans)
+}
+
+// reportOrphans writes the orphaned files of the given kind to w and returns
+// the resulting exit code.
+func reportOrphans(w io.Writer, kind string, orphans []string) int {
+	if len(orphans) == 0 {
+		return 0
+	}
+
+	fmt.Fprintf(w, "checkmate: found %s which no test used:\n", kind)
+	for _, path := range orphans {
+		fmt.Fprintf(w, "  %s\n", path)
+	}
+	fmt.Fprintln(w, "remove them if no test needs them anymore")
+	return 1
+}
+
+// testsFiltered reports whether only some of the tests were selected to run,
+// in which case unused files are expected. Tests which skip themselves in
+// short mode count as filtered out too.
+func testsFiltered() bool {
+	for _, name := range []string{"test.run", "test.skip"} {
+		if f := flag.Lookup(name); f != nil && f.Value.String() != "" {
+			return true
+		}
+	}
+	f := flag.Lookup("test.short")
+	return f != nil && f.Value.String() == "true"
+}
+
+// orphanedGoldenFiles returns the sorted golden files, under every golden
+// directory in use, which no test used.
+func orphanedGoldenFiles() ([]string, error) {
+	goldenFiles.mu.Lock()
+	defer goldenFiles.mu.Unlock()
+
+	var orphans []string
+	for root := range goldenFiles.roots {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if errors.Is(err, fs.ErrNotExist) && path == root {
+				return filepath.SkipDir
+			} else if err != nil {
+				return err
+			}
+
+			if !d.IsDir() && filepath.Ext(path) == ".golden" && !goldenFiles.used[filepath.Clean(path)] {
+				orphans = append(orphans, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Strings(orphans)
+	return orphans, nil
+}
+
+// markGoldenFileUsed records that path, inside of the golden directory
+// root, belongs to a test.
+func markGoldenFileUsed(root, path string) {
+	goldenFiles.mu.Lock()
+	defer goldenFiles.mu.Unlock()
+
+	goldenFiles.roots[filepath.Clean(root)] = true
+	goldenFiles.used[filepath.Clean(path)] = true
+}
+
+// writeGoldenFile writes data to path, creating its directory if needed.
+func writeGoldenFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// normalizeJSON re-indents the JSON document in data with sorted object
+// keys. Numbers keep their original text.
+func normalizeJSON(data []byte) ([]byte, error) {
+	value, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(canonicalJSON(value)), nil
+}
+
+// isBinary reports whether data should be compared as bytes rather than
+// text.
+func isBinary(data []byte) bool {
+	return !utf8.Valid(data) || bytes.IndexByte(data, 0) >= 0
+}
+
+// hexDump returns a hex dump of data without its final newline, so that it
+// can be diffed line by line.
+func hexDump(data []byte) string {
+	return strings.TrimSuffix(hex.Dump(data), "\n")
+}
+
+// namedT is a TestingT which knows the name of the running test.
+type namedT interface {
+	Name() string
+}
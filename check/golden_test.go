@@ -0,0 +1,161 @@
+package check
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+	"github.com/eugenetriguba/checkmate/internal/update"
+)
+
+func writeTestGoldenFile(t *testing.T, dir, testName, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, testName, name+".golden")
+	if err := writeGoldenFile(path, []byte(contents)); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestGolden(t *testing.T) {
+	dir := t.TempDir()
+	path := writeTestGoldenFile(t, dir, "TestGreeting/english", "greeting", "hello\nworld\n")
+	writeTestGoldenFile(t, dir, "TestGreeting/english", "binary", "\x00\x01\x02")
+	writeTestGoldenFile(t, dir, "TestGreeting/english", "user", `{"name": "gopher", "age": 13}`)
+
+	testCases := []struct {
+		name       string
+		file       string
+		actual     string
+		opts       []any
+		shouldPass bool
+		logMessage string
+	}{
+		{"Matching", "greeting", "hello\nworld\n", nil, true, ""},
+		{"Mismatch", "greeting", "hello\ngopher\n", nil, false,
+			"golden file " + path + " mismatch (-expected +actual):\n"},
+		{"Missing", "missing", "", nil, false,
+			"golden file " + filepath.Join(dir, "TestGreeting", "english", "missing.golden") +
+				" does not exist, run the tests with -checkmate.update or CHECKMATE_UPDATE=1 to create it"},
+		{"Binary", "binary", "\x00\x01\x03", nil, false,
+			"golden file " + filepath.Join(dir, "TestGreeting", "english", "binary.golden") +
+				" mismatch (-expected +actual):\n" +
+				"@@ -1,1 +1,1 @@\n" +
+				"-00000000  00 01 02                                          |...|\n" +
+				"+00000000  00 01 03                                          |...|"},
+		{"JSON ignores formatting", "user", "{\"age\":13,\n\"name\":\"gopher\"}", []any{GoldenJSON()}, true, ""},
+		{"JSON invalid", "user", "{", []any{GoldenJSON()}, false,
+			"cannot normalize actual value as JSON: unexpected EOF"},
+		{"Custom message", "greeting", "bye", []any{"greeting changed"}, false, "greeting changed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockTB{TestName: "TestGreeting/english"}
+
+			passed := Golden(mockT, tc.file, []byte(tc.actual), append([]any{GoldenDir(dir)}, tc.opts...)...)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || !strings.HasPrefix(mockT.Logs[0], tc.logMessage)) {
+				t.Errorf("%s: expected log message starting with '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestGoldenWithoutName(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	if Golden(mockT, "greeting", []byte("hello")) {
+		t.Fatal("expected Golden to fail")
+	}
+	if len(mockT.Logs) != 1 || mockT.Logs[0] != "cannot use golden files, *cmtest.MockT does not have a Name method" {
+		t.Errorf("unexpected logs: %v", mockT.Logs)
+	}
+}
+
+func TestGoldenUpdate(t *testing.T) {
+	t.Setenv(update.EnvVar, "1")
+	dir := t.TempDir()
+	mockT := &cmtest.MockTB{TestName: "TestUser"}
+
+	if !Golden(mockT, "user", []byte(`{"name":"gopher","tags":["<go>"]}`), GoldenDir(dir), GoldenJSON()) {
+		t.Fatalf("expected Golden to pass in update mode, logs: %v", mockT.Logs)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "TestUser", "user.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "{\n  \"name\": \"gopher\",\n  \"tags\": [\n    \"<go>\"\n  ]\n}\n"
+	if string(got) != want {
+		t.Errorf("expected golden file %q, got %q", want, got)
+	}
+}
+
+func TestOrphanedGoldenFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeTestGoldenFile(t, dir, "TestUsed", "output", "used")
+	orphan := writeTestGoldenFile(t, dir, "TestRemoved", "output", "orphan")
+	if err := os.WriteFile(filepath.Join(dir, "TestRemoved", "notes.txt"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	Golden(&cmtest.MockTB{TestName: "TestUsed"}, "output", []byte("used"), GoldenDir(dir))
+
+	orphans, err := orphanedGoldenFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var inDir []string
+	for _, path := range orphans {
+		if strings.HasPrefix(path, dir) {
+			inDir = append(inDir, path)
+		}
+	}
+	if len(inDir) != 1 || inDir[0] != orphan {
+		t.Fatalf("expected orphans [%s], got %v", orphan, inDir)
+	}
+
+	var out bytes.Buffer
+	if code := reportOrphans(&out, "golden files", inDir); code != 1 {
+		t.Errorf("expected exit code 1, got %d", code)
+	}
+	want := "checkmate: found golden files which no test used:\n  " + orphan +
+		"\nremove them if no test needs them anymore\n"
+	if out.String() != want {
+		t.Errorf("expected report %q, got %q", want, out.String())
+	}
+
+	t.Setenv(update.EnvVar, "1")
+	out.Reset()
+	if code := reportOrphans(&out, "golden files", inDir); code != 1 {
+		t.Errorf("expected exit code 1 in update mode, got %d", code)
+	}
+	if _, err := os.Stat(orphan); err != nil {
+		t.Errorf("expected %s to be kept in update mode, got %v", orphan, err)
+	}
+}
+
+func TestTestsFilteredInShortMode(t *testing.T) {
+	short := flag.Lookup("test.short")
+	previous := short.Value.String()
+	t.Cleanup(func() { short.Value.Set(previous) })
+
+	if err := short.Value.Set("true"); err != nil {
+		t.Fatal(err)
+	}
+	if !testsFiltered() {
+		t.Error("expected a run with -short to be filtered")
+	}
+}
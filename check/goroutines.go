@@ -0,0 +1,259 @@
+package check
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// defaultLeakGracePeriod is how long goroutines are given to exit before
+// they are reported as leaked.
+const defaultLeakGracePeriod = time.Second
+
+// defaultLeakIgnores match goroutines owned by the testing package and the
+// runtime, which come and go on their own.
+var defaultLeakIgnores = []func(g goroutine) bool{
+	stackContaining("testing.tRunner("),
+	stackContaining("testing.(*M).Run("),
+	stackContaining("testing.runTests("),
+	topFunction("os/signal.signal_recv"),
+	topFunction("os/signal.loop"),
+	topFunction("runtime.ensureSigM"),
+}
+
+// LeakOption configures how NoGoroutineLeaks and VerifyNoLeaks look for
+// leaked goroutines.
+type LeakOption func(*leakConfig)
+
+// leakConfig is the result of applying LeakOptions.
+type leakConfig struct {
+	gracePeriod time.Duration
+	ignores     []func(g goroutine) bool
+}
+
+// LeakGracePeriod sets how long goroutines are given to exit before they
+// are reported as leaked. It defaults to one second.
+func LeakGracePeriod(d time.Duration) LeakOption {
+	return func(config *leakConfig) {
+		config.gracePeriod = d
+	}
+}
+
+// IgnoreTopFunction ignores goroutines whose stack starts in the function
+// with the given fully qualified name, e.g. "database/sql.(*DB).connectionOpener".
+func IgnoreTopFunction(name string) LeakOption {
+	return func(config *leakConfig) {
+		config.ignores = append(config.ignores, topFunction(name))
+	}
+}
+
+// IgnoreStackContaining ignores goroutines whose stack trace contains substr
+// anywhere, such as the name of a function or a file.
+func IgnoreStackContaining(substr string) LeakOption {
+	return func(config *leakConfig) {
+		config.ignores = append(config.ignores, stackContaining(substr))
+	}
+}
+
+// NoGoroutineLeaks checks, once the test finishes, that every goroutine
+// started since the call has exited. Goroutines are given a grace period to
+// exit, and the stacks of the ones still running are logged on failure.
+//
+// t must have a Cleanup method, as *testing.T does. Goroutines started by
+// tests running in parallel are reported as well, so it is best suited to
+// tests which do not call t.Parallel.
+func NoGoroutineLeaks(t checkmate.TestingT, opts ...LeakOption) {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	ct, ok := t.(cleanupT)
+	if !ok {
+		check(t, false, "cannot check for goroutine leaks, %T does not have a Cleanup method", t)
+		return
+	}
+
+	config := newLeakConfig(opts...)
+	before := goroutineIDs(currentGoroutines())
+
+	ct.Cleanup(func() {
+		if ht, ok := t.(helperT); ok {
+			ht.Helper()
+		}
+
+		leaked := config.findLeaks(before)
+		check(t, len(leaked) == 0, "%s", describeLeaks(leaked))
+	})
+}
+
+// VerifyNoLeaks runs the tests and then checks that every goroutine they
+// started has exited, failing the run and printing the stacks of the leaked
+// goroutines if not. Nothing is checked when the tests fail.
+//
+// It returns the exit code to pass to os.Exit from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(check.VerifyNoLeaks(m))
+//	}
+func VerifyNoLeaks(m checkmate.M, opts ...LeakOption) int {
+	return verifyNoLeaks(os.Stderr, m, opts...)
+}
+
+func verifyNoLeaks(w io.Writer, m checkmate.M, opts ...LeakOption) int {
+	config := newLeakConfig(opts...)
+	before := goroutineIDs(currentGoroutines())
+
+	code := m.Run()
+	if code != 0 {
+		return code
+	}
+
+	if leaked := config.findLeaks(before); len(leaked) > 0 {
+		fmt.Fprintf(w, "checkmate: %s\n", describeLeaks(leaked))
+		return 1
+	}
+	return 0
+}
+
+// newLeakConfig applies opts after the defaults.
+func newLeakConfig(opts ...LeakOption) *leakConfig {
+	config := &leakConfig{
+		gracePeriod: defaultLeakGracePeriod,
+		ignores:     append([]func(g goroutine) bool(nil), defaultLeakIgnores...),
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// findLeaks returns the goroutines which are not in before and not
+// ignored, retrying with backoff until the grace period ends so that
+// goroutines which are about to exit are not reported.
+func (c *leakConfig) findLeaks(before map[int]bool) []goroutine {
+	deadline := time.Now().Add(c.gracePeriod)
+	interval := time.Millisecond
+
+	for {
+		var leaked []goroutine
+		for _, g := range currentGoroutines() {
+			if !before[g.id] && !c.ignored(g) {
+				leaked = append(leaked, g)
+			}
+		}
+
+		if len(leaked) == 0 || time.Now().After(deadline) {
+			return leaked
+		}
+
+		time.Sleep(min(interval, time.Until(deadline)))
+		interval = min(2*interval, 100*time.Millisecond)
+	}
+}
+
+func (c *leakConfig) ignored(g goroutine) bool {
+	for _, ignore := range c.ignores {
+		if ignore(g) {
+			return true
+		}
+	}
+	return false
+}
+
+// goroutine is a goroutine parsed from a runtime.Stack dump.
+type goroutine struct {
+	id          int
+	topFunction string
+	// stack is the goroutine's full trace, including its header.
+	stack string
+}
+
+// currentGoroutines returns every goroutine except the calling one.
+func currentGoroutines() []goroutine {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	// The calling goroutine always comes first.
+	traces := strings.Split(strings.TrimSpace(string(buf)), "\n\n")
+	goroutines := make([]goroutine, 0, len(traces)-1)
+	for _, trace := range traces[1:] {
+		if g, ok := parseGoroutine(trace); ok {
+			goroutines = append(goroutines, g)
+		}
+	}
+	return goroutines
+}
+
+// parseGoroutine parses a single goroutine's trace, which starts with a
+// header such as "goroutine 7 [chan receive]:" followed by a function line
+// and a file line per frame.
+func parseGoroutine(trace string) (goroutine, bool) {
+	header, frames, _ := strings.Cut(trace, "\n")
+	idText, _, ok := strings.Cut(strings.TrimPrefix(header, "goroutine "), " ")
+	if !ok {
+		return goroutine{}, false
+	}
+	id, err := strconv.Atoi(idText)
+	if err != nil {
+		return goroutine{}, false
+	}
+
+	top, _, _ := strings.Cut(frames, "\n")
+	if i := strings.LastIndex(top, "("); i > 0 {
+		top = top[:i]
+	}
+
+	return goroutine{id: id, topFunction: top, stack: trace}, true
+}
+
+// goroutineIDs returns the set of IDs of goroutines.
+func goroutineIDs(goroutines []goroutine) map[int]bool {
+	ids := make(map[int]bool, len(goroutines))
+	for _, g := range goroutines {
+		ids[g.id] = true
+	}
+	return ids
+}
+
+// describeLeaks formats the stacks of leaked goroutines.
+func describeLeaks(leaked []goroutine) string {
+	stacks := make([]string, len(leaked))
+	for i, g := range leaked {
+		stacks[i] = indent(g.stack)
+	}
+	noun := "goroutines"
+	if len(leaked) == 1 {
+		noun = "goroutine"
+	}
+	return fmt.Sprintf("found %d leaked %s:\n%s", len(leaked), noun, strings.Join(stacks, "\n\n"))
+}
+
+func topFunction(name string) func(g goroutine) bool {
+	return func(g goroutine) bool {
+		return g.topFunction == name
+	}
+}
+
+func stackContaining(substr string) func(g goroutine) bool {
+	return func(g goroutine) bool {
+		return strings.Contains(g.stack, substr)
+	}
+}
+
+// cleanupT is a TestingT which can run code once the test finishes.
+type cleanupT interface {
+	Cleanup(func())
+}
@@ -0,0 +1,120 @@
+package check
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+// blockUntilClosed parks the calling goroutine until done is closed. It is
+// the leaked goroutine in the tests below.
+func blockUntilClosed(done chan struct{}) {
+	<-done
+}
+
+// mainFunc adapts a function to checkmate.M.
+type mainFunc func() int
+
+func (f mainFunc) Run() int {
+	return f()
+}
+
+func TestNoGoroutineLeaks(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	mockT := &cmtest.MockTB{}
+	NoGoroutineLeaks(mockT, LeakGracePeriod(20*time.Millisecond))
+	go blockUntilClosed(done)
+	mockT.RunCleanups()
+
+	if !mockT.FailCalled || len(mockT.Logs) != 1 {
+		t.Fatalf("expected the leak to be reported, logs: %v", mockT.Logs)
+	}
+	if !strings.HasPrefix(mockT.Logs[0], "found 1 leaked goroutine:\n  goroutine ") ||
+		!strings.Contains(mockT.Logs[0], "check.blockUntilClosed(") {
+		t.Errorf("expected the leaked goroutine's stack, got:\n%s", mockT.Logs[0])
+	}
+}
+
+func TestNoGoroutineLeaksGracePeriod(t *testing.T) {
+	mockT := &cmtest.MockTB{}
+	NoGoroutineLeaks(mockT)
+
+	done := make(chan struct{})
+	go blockUntilClosed(done)
+	time.AfterFunc(20*time.Millisecond, func() { close(done) })
+	mockT.RunCleanups()
+
+	if mockT.FailCalled {
+		t.Errorf("expected a goroutine which exits within the grace period to pass, logs: %v", mockT.Logs)
+	}
+}
+
+func TestNoGoroutineLeaksIgnores(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	for _, opt := range []LeakOption{
+		IgnoreTopFunction("github.com/eugenetriguba/checkmate/check.blockUntilClosed"),
+		IgnoreStackContaining("goroutines_test.go"),
+	} {
+		mockT := &cmtest.MockTB{}
+		NoGoroutineLeaks(mockT, opt)
+		go blockUntilClosed(done)
+		mockT.RunCleanups()
+
+		if mockT.FailCalled {
+			t.Errorf("expected the goroutine to be ignored, logs: %v", mockT.Logs)
+		}
+	}
+}
+
+func TestNoGoroutineLeaksWithoutCleanup(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	NoGoroutineLeaks(mockT)
+
+	if !mockT.FailCalled || len(mockT.Logs) != 1 ||
+		mockT.Logs[0] != "cannot check for goroutine leaks, *cmtest.MockT does not have a Cleanup method" {
+		t.Errorf("unexpected logs: %v", mockT.Logs)
+	}
+}
+
+func TestVerifyNoLeaks(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+
+	var out bytes.Buffer
+	code := verifyNoLeaks(&out, mainFunc(func() int {
+		go blockUntilClosed(done)
+		return 0
+	}), LeakGracePeriod(0))
+
+	if code != 1 || !strings.HasPrefix(out.String(), "checkmate: found 1 leaked goroutine:\n") {
+		t.Errorf("expected the leak to fail the run, got code %d and output:\n%s", code, out.String())
+	}
+
+	out.Reset()
+	if code := verifyNoLeaks(&out, mainFunc(func() int { return 3 })); code != 3 || out.Len() != 0 {
+		t.Errorf("expected failing tests to keep their exit code, got %d and output %q", code, out.String())
+	}
+}
+
+func TestParseGoroutine(t *testing.T) {
+	g, ok := parseGoroutine("goroutine 42 [chan receive, 2 minutes]:\n" +
+		"example.com/pkg.(*Worker).loop(0xc000010000)\n" +
+		"\t/src/pkg/worker.go:12 +0x1d\n" +
+		"created by example.com/pkg.Start in goroutine 1\n" +
+		"\t/src/pkg/worker.go:5 +0x2a")
+
+	if !ok || g.id != 42 || g.topFunction != "example.com/pkg.(*Worker).loop" {
+		t.Errorf("unexpected goroutine %+v", g)
+	}
+	if _, ok := parseGoroutine("not a goroutine"); ok {
+		t.Error("expected an invalid trace not to parse")
+	}
+}
@@ -0,0 +1,206 @@
+package check
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Group runs fn with its own checkmate.TestingT and reports every failure
+// inside it as one numbered report, returning whether they all passed.
+// Checks inside fn keep running after a failure as usual, while an assert
+// failure ends fn early. Each failure is reported with the messages logged
+// since the previous one; messages logged after the last failure, or by a
+// passing group, are passed on to t unchanged.
+//
+// The group's TestingT has the Name, Cleanup, and Helper methods of t, when
+// t has them, so functions such as Golden and httpcheck.NewServer work
+// inside a group. Cleanup functions run when t finishes, after the group
+// has reported, so their failures are reported to t directly.
+//
+//	check.Group(t, "user fields", func(t checkmate.TestingT) {
+//		check.Equal(t, user.Name, "gopher")
+//		check.Equal(t, user.Age, 13)
+//	})
+func Group(t checkmate.TestingT, name string, fn func(t checkmate.TestingT), msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	group := newGroupT(t)
+	runIsolated(func() {
+		defer group.finish()
+		fn(group.testingT())
+	})
+
+	failures, trailing := group.results()
+	for _, log := range trailing {
+		t.Log(log)
+	}
+	if len(failures) == 0 {
+		return true
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"%s", describeGroupFailures(name, failures)}
+	}
+
+	return check(t, false, msgAndArgs...)
+}
+
+// groupT is the checkmate.TestingT given to a group. It records a failure
+// for every call to Fail or FailNow, along with the messages logged since
+// the previous failure. Once the group has finished, it passes every call
+// on to its parent instead.
+type groupT struct {
+	parent checkmate.TestingT
+
+	mu       sync.Mutex
+	pending  []string
+	failures []string
+	finished bool
+}
+
+func newGroupT(parent checkmate.TestingT) *groupT {
+	return &groupT{parent: parent}
+}
+
+// testingT returns g with the optional methods of its parent.
+func (g *groupT) testingT() checkmate.TestingT {
+	_, named := g.parent.(namedT)
+	_, cleanup := g.parent.(cleanupT)
+
+	switch {
+	case named && cleanup:
+		return namedCleanupGroupT{g}
+	case named:
+		return namedGroupT{g}
+	case cleanup:
+		return cleanupGroupT{g}
+	default:
+		return g
+	}
+}
+
+func (g *groupT) Log(args ...any) {
+	recorded := g.record(func() {
+		g.pending = append(g.pending, fmt.Sprint(args...))
+	})
+	if !recorded {
+		g.parent.Log(args...)
+	}
+}
+
+func (g *groupT) Fail() {
+	recorded := g.record(func() {
+		g.failures = append(g.failures, strings.Join(g.pending, "\n"))
+		g.pending = nil
+	})
+	if !recorded {
+		g.parent.Fail()
+	}
+}
+
+// FailNow records a failure and ends the group's function, or stops the
+// parent once the group has finished.
+func (g *groupT) FailNow() {
+	recorded := g.record(func() {
+		g.failures = append(g.failures, strings.Join(g.pending, "\n"))
+		g.pending = nil
+	})
+	if !recorded {
+		g.parent.FailNow()
+		return
+	}
+	runtime.Goexit()
+}
+
+func (g *groupT) Helper() {
+	if ht, ok := g.parent.(helperT); ok {
+		ht.Helper()
+	}
+}
+
+// record runs fn while holding the lock, unless the group has finished. It
+// reports whether fn was run.
+func (g *groupT) record(fn func()) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.finished {
+		return false
+	}
+	fn()
+	return true
+}
+
+// finish makes g pass every further call on to its parent.
+func (g *groupT) finish() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.finished = true
+}
+
+// results returns the recorded failures and the messages logged after the
+// last of them.
+func (g *groupT) results() (failures, trailing []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]string(nil), g.failures...), append([]string(nil), g.pending...)
+}
+
+type namedGroupT struct{ *groupT }
+
+func (g namedGroupT) Name() string {
+	return g.parent.(namedT).Name()
+}
+
+type cleanupGroupT struct{ *groupT }
+
+func (g cleanupGroupT) Cleanup(fn func()) {
+	g.parent.(cleanupT).Cleanup(fn)
+}
+
+type namedCleanupGroupT struct{ *groupT }
+
+func (g namedCleanupGroupT) Name() string {
+	return g.parent.(namedT).Name()
+}
+
+func (g namedCleanupGroupT) Cleanup(fn func()) {
+	g.parent.(cleanupT).Cleanup(fn)
+}
+
+// describeGroupFailures formats the failures of a group as a numbered list,
+// e.g.
+//
+//	group "user fields" had 2 failures:
+//	  1. expected 13, got 12
+//	  2. expected "gopher", got "gohper"
+func describeGroupFailures(name string, failures []string) string {
+	items := make([]string, len(failures))
+	for i, failure := range failures {
+		if failure == "" {
+			failure = "failed without a message"
+		}
+		items[i] = numberedItem(i+1, failure)
+	}
+
+	noun := "failures"
+	if len(failures) == 1 {
+		noun = "failure"
+	}
+	return fmt.Sprintf("group %q had %d %s:\n%s", name, len(failures), noun, indent(strings.Join(items, "\n")))
+}
+
+// numberedItem prefixes message with its number, aligning any further lines
+// of the message with the first.
+func numberedItem(number int, message string) string {
+	prefix := fmt.Sprintf("%d. ", number)
+	return prefix + strings.ReplaceAll(message, "\n", "\n"+strings.Repeat(" ", len(prefix)))
+}
@@ -0,0 +1,175 @@
+package check
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestGroup(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT)
+		shouldPass bool
+		logMessage string
+	}{
+		{"Passing", func(t checkmate.TestingT) { Equal(t, 1, 1) }, true, ""},
+		{"One failure", func(t checkmate.TestingT) {
+			Equal(t, 1, 1)
+			True(t, false)
+		}, false, "group \"user fields\" had 1 failure:\n  1. expected condition to be true, got false"},
+		{"Every failure is numbered", func(t checkmate.TestingT) {
+			True(t, false)
+			Equal(t, "gopher", "gohper")
+			Empty(t, []int{1})
+		}, false,
+			"group \"user fields\" had 3 failures:\n" +
+				"  1. expected condition to be true, got false\n" +
+				"  2. strings differ:\n" +
+				"       actual:   \"gopher\"\n" +
+				"       expected: \"gohper\"\n" +
+				"                    ^ first difference at rune 2\n" +
+				"  3. expected [1] to be empty, got length 1"},
+		{"FailNow ends the group", func(t checkmate.TestingT) {
+			True(t, false)
+			t.FailNow()
+			True(t, false)
+		}, false, "group \"user fields\" had 2 failures:\n" +
+			"  1. expected condition to be true, got false\n" +
+			"  2. failed without a message"},
+		{"Failure without a message", func(t checkmate.TestingT) { t.Fail() }, false,
+			"group \"user fields\" had 1 failure:\n  1. failed without a message"},
+		{"Logs are grouped with the next failure", func(t checkmate.TestingT) {
+			t.Log("checking the name")
+			Equal(t, 1, 2)
+			t.Log("checking the age")
+			t.Log("age is optional")
+			Equal(t, 1, 1)
+			True(t, false)
+		}, false, "group \"user fields\" had 2 failures:\n" +
+			"  1. checking the name\n" +
+			"     expected 1 to equal 2\n" +
+			"  2. checking the age\n" +
+			"     age is optional\n" +
+			"     expected condition to be true, got false"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := Group(mockT, "user fields", tc.fn)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestGroupPassesOnLogs(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	passed := Group(mockT, "logging", func(t checkmate.TestingT) {
+		t.Log("first")
+		t.Log("second")
+	})
+
+	if !passed || mockT.FailCalled {
+		t.Fatalf("Group should have passed, logs: %v", mockT.Logs)
+	}
+	if len(mockT.Logs) != 2 || mockT.Logs[0] != "first" || mockT.Logs[1] != "second" {
+		t.Errorf("expected the group's logs to be passed on, got %v", mockT.Logs)
+	}
+}
+
+func TestGroupPassesOnLogsAfterTheLastFailure(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	passed := Group(mockT, "trailing", func(t checkmate.TestingT) {
+		True(t, false)
+		t.Log("done")
+	})
+
+	if passed || !mockT.FailCalled {
+		t.Fatal("Group should have failed")
+	}
+	expected := []string{"done", "group \"trailing\" had 1 failure:\n  1. expected condition to be true, got false"}
+	if len(mockT.Logs) != 2 || mockT.Logs[0] != expected[0] || mockT.Logs[1] != expected[1] {
+		t.Errorf("expected logs %q, got %q", expected, mockT.Logs)
+	}
+}
+
+func TestGroupGolden(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "TestGreeting"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "TestGreeting", "greeting.golden"), []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mockT := &cmtest.MockTB{TestName: "TestGreeting"}
+
+	passed := Group(mockT, "greeting", func(t checkmate.TestingT) {
+		Golden(t, "greeting", []byte("hello"), GoldenDir(dir))
+	})
+
+	if !passed || mockT.FailCalled {
+		t.Errorf("expected the golden file to be found through the group, logs: %v", mockT.Logs)
+	}
+}
+
+func TestGroupCleanupRunsWithTheParent(t *testing.T) {
+	mockT := &cmtest.MockTB{}
+
+	passed := Group(mockT, "cleanup", func(t checkmate.TestingT) {
+		t.(cleanupT).Cleanup(func() {
+			t.Log("cleaned up")
+			t.Fail()
+		})
+	})
+
+	if !passed || mockT.FailCalled {
+		t.Fatalf("Group should have passed, logs: %v", mockT.Logs)
+	}
+	mockT.RunCleanups()
+	if !mockT.FailCalled || len(mockT.Logs) != 1 || mockT.Logs[0] != "cleaned up" {
+		t.Errorf("expected the cleanup to report to the parent, FailCalled = %v, logs: %v", mockT.FailCalled, mockT.Logs)
+	}
+}
+
+func TestGroupWithoutParentMethods(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	Group(mockT, "plain", func(t checkmate.TestingT) {
+		if _, ok := t.(namedT); ok {
+			True(t, false, "expected no Name method")
+		}
+		if _, ok := t.(cleanupT); ok {
+			True(t, false, "expected no Cleanup method")
+		}
+	})
+
+	if mockT.FailCalled {
+		t.Errorf("expected the group to only have the methods of its parent, logs: %v", mockT.Logs)
+	}
+}
+
+func TestGroupRepanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("expected the panic to be re-raised, got %v", r)
+		}
+	}()
+
+	Group(&cmtest.MockT{}, "panicking", func(t checkmate.TestingT) { panic("boom") })
+}
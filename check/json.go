@@ -0,0 +1,396 @@
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// DocumentOption configures how JSONEq compares documents. Like
+// CompareOption, options are passed in place of, or alongside, msgAndArgs.
+//
+//	check.JSONEq(t, body, want, check.IgnorePaths("/id", "/items/*/createdAt"))
+type DocumentOption func(*documentConfig)
+
+// documentConfig is the result of applying DocumentOptions.
+type documentConfig struct {
+	// ignorePaths holds the segments of each ignored JSON pointer.
+	ignorePaths      [][]string
+	numberTolerance  float64
+	allowExtraFields bool
+	showCanonical    bool
+}
+
+// IgnorePaths skips the values at the given JSON pointers, such as
+// "/items/0/id", in both documents. A "*" segment matches any object key or
+// array index, e.g. "/items/*/id".
+func IgnorePaths(pointers ...string) DocumentOption {
+	return func(config *documentConfig) {
+		for _, pointer := range pointers {
+			config.ignorePaths = append(config.ignorePaths, parsePointer(pointer))
+		}
+	}
+}
+
+// NumberTolerance treats two numbers as equal when they differ by no more
+// than delta.
+func NumberTolerance(delta float64) DocumentOption {
+	return func(config *documentConfig) {
+		config.numberTolerance = delta
+	}
+}
+
+// AllowExtraFields ignores object members which are present in the actual
+// document but not in the expected one, at any depth.
+func AllowExtraFields() DocumentOption {
+	return func(config *documentConfig) {
+		config.allowExtraFields = true
+	}
+}
+
+// ShowCanonical adds the canonical form of both documents to the failure
+// message, which helps when debugging why two documents differ.
+func ShowCanonical() DocumentOption {
+	return func(config *documentConfig) {
+		config.showCanonical = true
+	}
+}
+
+// JSONEq checks whether actual and expected are equivalent JSON documents,
+// regardless of object key order and whitespace. Each document may be a
+// []byte, a string, or an io.Reader. Differences are reported by their JSON
+// pointer, e.g. `/items/3/name: "a" != "b"`, with the actual value first.
+func JSONEq(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	opts, msgAndArgs := splitOptions[DocumentOption](msgAndArgs)
+
+	actualDoc, err := readJSONDocument(actual)
+	if err != nil {
+		return check(t, false, "cannot decode actual JSON: %v", err)
+	}
+	expectedDoc, err := readJSONDocument(expected)
+	if err != nil {
+		return check(t, false, "cannot decode expected JSON: %v", err)
+	}
+
+	config := newDocumentConfig(opts...)
+	differences := config.compare(expectedDoc, actualDoc)
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"JSON documents differ (actual != expected):\n%s%s",
+			formatDifferences(differences, formatPointer),
+			config.canonical(canonicalJSON(actualDoc), canonicalJSON(expectedDoc)),
+		}
+	}
+
+	return check(t, len(differences) == 0, msgAndArgs...)
+}
+
+// JSONEqualTo matches JSON documents equivalent to expected, compared as
+// JSONEq compares them. Both may be a []byte, a string, or an io.Reader.
+func JSONEqualTo(expected any, opts ...DocumentOption) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		actualDoc, err := readJSONDocument(actual)
+		if err != nil {
+			return false, fmt.Sprintf("cannot decode actual JSON: %v", err)
+		}
+		expectedDoc, err := readJSONDocument(expected)
+		if err != nil {
+			return false, fmt.Sprintf("cannot decode expected JSON: %v", err)
+		}
+
+		differences := newDocumentConfig(opts...).compare(expectedDoc, actualDoc)
+		if len(differences) == 0 {
+			return true, "JSON documents are equivalent"
+		}
+		return false, "JSON documents differ (actual != expected):\n" + formatDifferences(differences, formatPointer)
+	})
+}
+
+// readDocument returns the contents of a document given as a []byte, a
+// string, or an io.Reader.
+func readDocument(doc any) ([]byte, error) {
+	switch doc := doc.(type) {
+	case []byte:
+		return doc, nil
+	case json.RawMessage:
+		return doc, nil
+	case string:
+		return []byte(doc), nil
+	case io.Reader:
+		return io.ReadAll(doc)
+	default:
+		return nil, fmt.Errorf("unsupported document type %T, want []byte, string, or io.Reader", doc)
+	}
+}
+
+// readJSONDocument reads and decodes a JSON document given as a []byte, a
+// string, or an io.Reader.
+func readJSONDocument(doc any) (any, error) {
+	data, err := readDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSON(data)
+}
+
+// decodeJSON decodes a single JSON value, keeping numbers as json.Number so
+// that no precision is lost.
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the top-level value")
+	}
+	return value, nil
+}
+
+// newDocumentConfig applies opts to an empty configuration.
+func newDocumentConfig(opts ...DocumentOption) *documentConfig {
+	config := &documentConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// documentDifference is a single difference between two documents.
+type documentDifference struct {
+	// path holds the object keys, as strings, and array indexes, as ints,
+	// leading to the difference.
+	path    []any
+	message string
+}
+
+// compare returns the differences between two decoded documents, made of
+// map[string]any, []any, json.Number, string, bool, and nil values.
+func (c *documentConfig) compare(expected, actual any) []documentDifference {
+	var differences []documentDifference
+	c.compareAt(nil, expected, actual, &differences)
+	return differences
+}
+
+func (c *documentConfig) compareAt(path []any, expected, actual any, differences *[]documentDifference) {
+	if c.ignored(path) {
+		return
+	}
+
+	addDifference := func(path []any, format string, args ...any) {
+		if !c.ignored(path) {
+			*differences = append(*differences, documentDifference{path, fmt.Sprintf(format, args...)})
+		}
+	}
+	mismatch := func() {
+		addDifference(path, "%s != %s", formatJSONValue(actual), formatJSONValue(expected))
+	}
+
+	switch expected := expected.(type) {
+	case map[string]any:
+		actual, ok := actual.(map[string]any)
+		if !ok {
+			mismatch()
+			return
+		}
+
+		for _, key := range sortedKeys(expected) {
+			if actualValue, ok := actual[key]; ok {
+				c.compareAt(appendPath(path, key), expected[key], actualValue, differences)
+			} else {
+				addDifference(appendPath(path, key), "missing, expected %s", formatJSONValue(expected[key]))
+			}
+		}
+		if c.allowExtraFields {
+			return
+		}
+		for _, key := range sortedKeys(actual) {
+			if _, ok := expected[key]; !ok {
+				addDifference(appendPath(path, key), "unexpected %s", formatJSONValue(actual[key]))
+			}
+		}
+	case []any:
+		actual, ok := actual.([]any)
+		if !ok {
+			mismatch()
+			return
+		}
+
+		for i := 0; i < max(len(expected), len(actual)); i++ {
+			switch {
+			case i >= len(actual):
+				addDifference(appendPath(path, i), "missing, expected %s", formatJSONValue(expected[i]))
+			case i >= len(expected):
+				addDifference(appendPath(path, i), "unexpected %s", formatJSONValue(actual[i]))
+			default:
+				c.compareAt(appendPath(path, i), expected[i], actual[i], differences)
+			}
+		}
+	case json.Number:
+		actual, ok := actual.(json.Number)
+		if !ok || !numbersEqual(expected, actual, c.numberTolerance) {
+			mismatch()
+		}
+	default:
+		if expected != actual {
+			mismatch()
+		}
+	}
+}
+
+// ignored reports whether path matches one of the ignored JSON pointers.
+func (c *documentConfig) ignored(path []any) bool {
+	for _, pattern := range c.ignorePaths {
+		if len(pattern) != len(path) {
+			continue
+		}
+
+		matched := true
+		for i, segment := range pattern {
+			if segment != "*" && segment != fmt.Sprint(path[i]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// canonical returns the canonical forms of both documents to append to a
+// failure message, or nothing without ShowCanonical.
+func (c *documentConfig) canonical(actual, expected string) string {
+	if !c.showCanonical {
+		return ""
+	}
+	return fmt.Sprintf(
+		"\ncanonical actual:\n%s\ncanonical expected:\n%s",
+		indent(strings.TrimSuffix(actual, "\n")), indent(strings.TrimSuffix(expected, "\n")),
+	)
+}
+
+// canonicalJSON formats a decoded document as indented JSON with sorted
+// object keys.
+func canonicalJSON(doc any) string {
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Sprintf("%v", doc)
+	}
+	return out.String()
+}
+
+// formatDifferences formats one difference per line, each path formatted
+// by formatPath.
+func formatDifferences(differences []documentDifference, formatPath func(path []any) string) string {
+	lines := make([]string, len(differences))
+	for i, difference := range differences {
+		lines[i] = fmt.Sprintf("%s: %s", formatPath(difference.path), difference.message)
+	}
+	return indent(strings.Join(lines, "\n"))
+}
+
+// numbersEqual compares two JSON numbers exactly, or within tolerance when
+// it is positive. Numbers are compared by value, so 1 and 1.0 are equal.
+func numbersEqual(expected, actual json.Number, tolerance float64) bool {
+	if expected == actual {
+		return true
+	}
+
+	expectedRat, ok := new(big.Rat).SetString(expected.String())
+	if !ok {
+		return false
+	}
+	actualRat, ok := new(big.Rat).SetString(actual.String())
+	if !ok {
+		return false
+	}
+
+	difference := new(big.Rat).Sub(expectedRat, actualRat)
+	if tolerance <= 0 {
+		return difference.Sign() == 0
+	}
+	delta, _ := difference.Abs(difference).Float64()
+	return delta <= tolerance
+}
+
+// parsePointer splits a JSON pointer into its unescaped segments. The empty
+// pointer refers to the whole document.
+func parsePointer(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+	}
+	return segments
+}
+
+// formatPointer formats path segments as a JSON pointer.
+func formatPointer(path []any) string {
+	if len(path) == 0 {
+		return "(root)"
+	}
+
+	var out strings.Builder
+	for _, segment := range path {
+		out.WriteString("/")
+		out.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(fmt.Sprint(segment)))
+	}
+	return out.String()
+}
+
+// formatJSONValue formats a decoded value as compact JSON. The YAML values
+// without a JSON equivalent are formatted as they are written in YAML.
+func formatJSONValue(value any) string {
+	switch value := value.(type) {
+	case yamlNonFinite:
+		return string(value)
+	case yamlTimestamp:
+		return string(value)
+	}
+
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// appendPath returns a copy of path with segment added, so that sibling
+// paths never share a backing array.
+func appendPath(path []any, segment any) []any {
+	return append(path[:len(path):len(path)], segment)
+}
+
+// sortedKeys returns the keys of an object in sorted order.
+func sortedKeys(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,112 @@
+package check
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestJSONEq(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"Key order and whitespace", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"b": [1, 2], "a": "x"}`, []byte(`{"a":"x","b":[1,2]}`))
+		}, true, ""},
+		{"Reader", func(t checkmate.TestingT) bool {
+			return JSONEq(t, strings.NewReader(`{"a": 1}`), `{"a": 1.0}`)
+		}, true, ""},
+		{"Nested value", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"items": [{"name": "a"}, {"name": "b"}]}`, `{"items": [{"name": "a"}, {"name": "c"}]}`)
+		}, false, "JSON documents differ (actual != expected):\n  /items/1/name: \"b\" != \"c\""},
+		{"Missing and unexpected members", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"a": 1, "c/d": true}`, `{"a": 1, "b": null}`)
+		}, false, "JSON documents differ (actual != expected):\n" +
+			"  /b: missing, expected null\n" +
+			"  /c~1d: unexpected true"},
+		{"Array length", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `[1, 2, 3]`, `[1, 2]`)
+		}, false, "JSON documents differ (actual != expected):\n  /2: unexpected 3"},
+		{"Types differ", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"id": "1"}`, `{"id": 1}`)
+		}, false, "JSON documents differ (actual != expected):\n  /id: \"1\" != 1"},
+		{"Root", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `[]`, `{}`)
+		}, false, "JSON documents differ (actual != expected):\n  (root): [] != {}"},
+		{"Large integers", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `12345678901234567891`, `12345678901234567892`)
+		}, false, "JSON documents differ (actual != expected):\n  (root): 12345678901234567891 != 12345678901234567892"},
+		{"Number tolerance", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"price": 9.991}`, `{"price": 9.99}`, NumberTolerance(0.01))
+		}, true, ""},
+		{"Ignore paths", func(t checkmate.TestingT) bool {
+			return JSONEq(
+				t, `{"id": 7, "items": [{"at": 1, "n": 1}]}`, `{"items": [{"at": 2, "n": 1}]}`,
+				IgnorePaths("/id", "/items/*/at"),
+			)
+		}, true, ""},
+		{"Allow extra fields", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"a": {"b": 1, "c": 2}, "d": 3}`, `{"a": {"b": 1}}`, AllowExtraFields())
+		}, true, ""},
+		{"Allow extra fields still compares arrays", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"a": [1, 2]}`, `{"a": [1]}`, AllowExtraFields())
+		}, false, "JSON documents differ (actual != expected):\n  /a/1: unexpected 2"},
+		{"Show canonical", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"b":1,"a":[true]}`, `{"a":[false],"b":1}`, ShowCanonical())
+		}, false, "JSON documents differ (actual != expected):\n" +
+			"  /a/0: true != false\n" +
+			"canonical actual:\n  {\n    \"a\": [\n      true\n    ],\n    \"b\": 1\n  }\n" +
+			"canonical expected:\n  {\n    \"a\": [\n      false\n    ],\n    \"b\": 1\n  }"},
+		{"Custom message", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `1`, `2`, NumberTolerance(0.5), "response body changed")
+		}, false, "response body changed"},
+		{"Invalid JSON", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{"a": }`, `{}`)
+		}, false, "cannot decode actual JSON: invalid character '}' looking for beginning of value"},
+		{"Trailing data", func(t checkmate.TestingT) bool {
+			return JSONEq(t, `{}`, `{} {}`)
+		}, false, "cannot decode expected JSON: unexpected data after the top-level value"},
+		{"Unsupported type", func(t checkmate.TestingT) bool {
+			return JSONEq(t, map[string]any{}, `{}`)
+		}, false, "cannot decode actual JSON: unsupported document type map[string]interface {}, " +
+			"want []byte, string, or io.Reader"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestJSONEqualTo(t *testing.T) {
+	matcher := JSONEqualTo(`{"a": [1, 2]}`, IgnorePaths("/b"))
+
+	if matched, description := matcher.Match(`{"b": 3, "a": [1, 2.0]}`); !matched {
+		t.Errorf("expected documents to match, got %q", description)
+	}
+	matched, description := matcher.Match([]byte(`{"a": [1]}`))
+	if matched || description != "JSON documents differ (actual != expected):\n  /a/1: missing, expected 2" {
+		t.Errorf("expected documents not to match, got %v with %q", matched, description)
+	}
+	if matched, description := matcher.Match(42); matched || !strings.HasPrefix(description, "cannot decode actual JSON") {
+		t.Errorf("expected a decode failure, got %v with %q", matched, description)
+	}
+}
@@ -0,0 +1,315 @@
+package check
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// JSONPath checks whether the value at path in the JSON document doc equals
+// expected. The document may be a []byte, a string, or an io.Reader, and
+// expected is any value which encodes to JSON.
+//
+// path supports a subset of JSONPath: the root $, member access with .name
+// or ['name'], and array indexes such as [0], or [-1] for the last element.
+// When the path does not exist, the failure names the nearest path which
+// does. DocumentOptions apply to the selected value.
+//
+//	check.JSONPath(t, body, "$.items[0].id", 42)
+func JSONPath(t checkmate.TestingT, doc any, path string, expected any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return check(t, false, "invalid JSON path %q: %v", path, err)
+	}
+
+	opts, msgAndArgs := splitOptions[DocumentOption](msgAndArgs)
+
+	actualDoc, err := readJSONDocument(doc)
+	if err != nil {
+		return check(t, false, "cannot decode JSON: %v", err)
+	}
+	expectedJSON, err := json.Marshal(expected)
+	if err != nil {
+		return check(t, false, "cannot encode expected value as JSON: %v", err)
+	}
+	expectedDoc, err := decodeJSON(expectedJSON)
+	if err != nil {
+		return check(t, false, "cannot encode expected value as JSON: %v", err)
+	}
+
+	resolved, actual, found := resolveJSONPath(actualDoc, segments)
+	if !found {
+		if len(msgAndArgs) == 0 {
+			msgAndArgs = []any{"%s", describeMissingPath(segments[:len(resolved)+1], resolved, actual)}
+		}
+		return check(t, false, msgAndArgs...)
+	}
+
+	differences := newDocumentConfig(opts...).compare(expectedDoc, actual)
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"JSON at %s differs (actual != expected):\n%s",
+			formatJSONPath(resolved), formatDifferences(differences, func(path []any) string {
+				return formatJSONPath(append(resolved[:len(resolved):len(resolved)], path...))
+			}),
+		}
+	}
+
+	return check(t, len(differences) == 0, msgAndArgs...)
+}
+
+// JSONContains checks whether the JSON document partial is structurally
+// present in doc. Every member of a partial object must exist in the
+// corresponding object of doc with a matching value, and element i of a
+// partial array must be contained in element i of doc's array. Both
+// documents may be a []byte, a string, or an io.Reader.
+//
+//	check.JSONContains(t, body, `{"user": {"name": "gopher"}}`)
+func JSONContains(t checkmate.TestingT, doc, partial any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	actualDoc, err := readJSONDocument(doc)
+	if err != nil {
+		return check(t, false, "cannot decode JSON: %v", err)
+	}
+	partialDoc, err := readJSONDocument(partial)
+	if err != nil {
+		return check(t, false, "cannot decode partial JSON: %v", err)
+	}
+
+	var differences []documentDifference
+	jsonContainsAt(nil, actualDoc, partialDoc, &differences)
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"JSON does not contain the partial document (actual != expected):\n%s",
+			formatDifferences(differences, formatJSONPath),
+		}
+	}
+
+	return check(t, len(differences) == 0, msgAndArgs...)
+}
+
+// jsonContainsAt records where partial is not contained in actual.
+func jsonContainsAt(path []any, actual, partial any, differences *[]documentDifference) {
+	mismatch := func() {
+		*differences = append(*differences, documentDifference{
+			path, fmt.Sprintf("%s != %s", formatJSONValue(actual), formatJSONValue(partial)),
+		})
+	}
+	missing := func(segment any) {
+		*differences = append(*differences, documentDifference{
+			appendPath(path, segment), fmt.Sprintf(
+				"does not exist, nearest existing path %s is %s",
+				formatJSONPath(path), describeJSONValue(actual),
+			),
+		})
+	}
+
+	switch partial := partial.(type) {
+	case map[string]any:
+		actual, ok := actual.(map[string]any)
+		if !ok {
+			mismatch()
+			return
+		}
+		for _, key := range sortedKeys(partial) {
+			if actualValue, ok := actual[key]; ok {
+				jsonContainsAt(appendPath(path, key), actualValue, partial[key], differences)
+			} else {
+				missing(key)
+			}
+		}
+	case []any:
+		actual, ok := actual.([]any)
+		if !ok {
+			mismatch()
+			return
+		}
+		for i := range partial {
+			if i < len(actual) {
+				jsonContainsAt(appendPath(path, i), actual[i], partial[i], differences)
+			} else {
+				missing(i)
+			}
+		}
+	case json.Number:
+		actual, ok := actual.(json.Number)
+		if !ok || !numbersEqual(partial, actual, 0) {
+			mismatch()
+		}
+	default:
+		if partial != actual {
+			mismatch()
+		}
+	}
+}
+
+// parseJSONPath parses the supported JSONPath subset into object keys, as
+// strings, and array indexes, as ints.
+func parseJSONPath(path string) ([]any, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, errors.New("path must start with $")
+	}
+
+	var segments []any
+	rest := path[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "."):
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			name := rest[1 : end+1]
+			if name == "" {
+				return nil, errors.New("empty member name")
+			} else if name == "*" {
+				return nil, errors.New("wildcards are not supported")
+			}
+			segments = append(segments, name)
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "['") || strings.HasPrefix(rest, `["`):
+			quote := rest[1]
+			end := strings.IndexByte(rest[2:], quote)
+			if end == -1 || !strings.HasPrefix(rest[2+end+1:], "]") {
+				return nil, fmt.Errorf("unterminated member name in %q", rest)
+			}
+			segments = append(segments, rest[2:2+end])
+			rest = rest[2+end+2:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated index in %q", rest)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q", rest[1:end])
+			}
+			segments = append(segments, index)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q", rest)
+		}
+	}
+	return segments, nil
+}
+
+// resolveJSONPath follows segments through doc. It returns the resolved
+// path, with negative indexes made absolute, and the value there. When the
+// path does not exist, it returns the longest existing prefix and its value
+// instead, and found is false.
+func resolveJSONPath(doc any, segments []any) (resolved []any, value any, found bool) {
+	value = doc
+	for _, segment := range segments {
+		switch segment := segment.(type) {
+		case string:
+			object, ok := value.(map[string]any)
+			if !ok {
+				return resolved, value, false
+			}
+			child, ok := object[segment]
+			if !ok {
+				return resolved, value, false
+			}
+			resolved, value = appendPath(resolved, segment), child
+		case int:
+			array, ok := value.([]any)
+			if !ok {
+				return resolved, value, false
+			}
+			index := segment
+			if index < 0 {
+				index += len(array)
+			}
+			if index < 0 || index >= len(array) {
+				return resolved, value, false
+			}
+			resolved, value = appendPath(resolved, index), array[index]
+		}
+	}
+	return resolved, value, true
+}
+
+// describeMissingPath explains that path does not exist, given the nearest
+// existing path and its value.
+func describeMissingPath(path, nearest []any, value any) string {
+	return fmt.Sprintf(
+		"%s does not exist, nearest existing path %s is %s",
+		formatJSONPath(path), formatJSONPath(nearest), describeJSONValue(value),
+	)
+}
+
+// describeJSONValue describes the shape of a decoded value, listing the
+// members of an object and the length of an array.
+func describeJSONValue(value any) string {
+	switch value := value.(type) {
+	case map[string]any:
+		if len(value) == 0 {
+			return "an empty object"
+		}
+		keys := sortedKeys(value)
+		for i, key := range keys {
+			keys[i] = strconv.Quote(key)
+		}
+		return "an object with members " + strings.Join(keys, ", ")
+	case []any:
+		if len(value) == 1 {
+			return "an array of 1 element"
+		}
+		return fmt.Sprintf("an array of %d elements", len(value))
+	case json.Number:
+		return "the number " + value.String()
+	case string:
+		return "the string " + strconv.Quote(value)
+	case bool:
+		return fmt.Sprintf("the boolean %t", value)
+	default:
+		return "null"
+	}
+}
+
+// formatJSONPath formats path segments as a JSONPath expression.
+func formatJSONPath(path []any) string {
+	var out strings.Builder
+	out.WriteString("$")
+	for _, segment := range path {
+		switch segment := segment.(type) {
+		case int:
+			fmt.Fprintf(&out, "[%d]", segment)
+		case string:
+			if isJSONPathIdentifier(segment) {
+				fmt.Fprintf(&out, ".%s", segment)
+			} else {
+				fmt.Fprintf(&out, "[%s]", strconv.Quote(segment))
+			}
+		}
+	}
+	return out.String()
+}
+
+// isJSONPathIdentifier reports whether name can be written after a dot in
+// a JSONPath expression.
+func isJSONPathIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		isLetter := r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !isLetter && !(i > 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
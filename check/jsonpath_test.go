@@ -0,0 +1,118 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+const jsonPathDoc = `{
+	"items": [
+		{"id": 1, "name": "apple", "tags": ["fruit", "red"]},
+		{"id": 2, "name": "pear", "price": 1.5}
+	],
+	"owner": {"first name": "Ada"}
+}`
+
+func TestJSONPath(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"Scalar", func(t checkmate.TestingT) bool { return JSONPath(t, jsonPathDoc, "$.items[0].id", 1) }, true, ""},
+		{"Negative index", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.items[-1].price", 1.5)
+		}, true, ""},
+		{"Bracket member", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.owner['first name']", "Ada")
+		}, true, ""},
+		{"Object", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.items[1]", map[string]any{"id": 2, "name": "pear", "price": 1.5})
+		}, true, ""},
+		{"Mismatch", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.items[0].id", 3)
+		}, false, "JSON at $.items[0].id differs (actual != expected):\n  $.items[0].id: 1 != 3"},
+		{"Nested mismatch", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.items[-2]", map[string]any{"id": 1, "name": "apple", "tags": []string{"fruit"}})
+		}, false, "JSON at $.items[0] differs (actual != expected):\n  $.items[0].tags[1]: unexpected \"red\""},
+		{"Missing member", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.items[0].price", 1)
+		}, false, "$.items[0].price does not exist, nearest existing path $.items[0] is " +
+			"an object with members \"id\", \"name\", \"tags\""},
+		{"Index out of range", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.items[5].id", 1)
+		}, false, "$.items[5] does not exist, nearest existing path $.items is an array of 2 elements"},
+		{"Through a scalar", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.items[1].name.first", 1)
+		}, false, "$.items[1].name.first does not exist, nearest existing path $.items[1].name is the string \"pear\""},
+		{"Invalid path", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "items[0]", 1)
+		}, false, "invalid JSON path \"items[0]\": path must start with $"},
+		{"Unsupported wildcard", func(t checkmate.TestingT) bool {
+			return JSONPath(t, jsonPathDoc, "$.items.*", 1)
+		}, false, "invalid JSON path \"$.items.*\": wildcards are not supported"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestJSONContains(t *testing.T) {
+	testCases := []struct {
+		name       string
+		partial    string
+		shouldPass bool
+		logMessage string
+	}{
+		{"Empty object", `{}`, true, ""},
+		{"Nested members", `{"items": [{"name": "apple"}, {"price": 1.50}]}`, true, ""},
+		{"Array prefix", `{"items": [{"tags": ["fruit"]}]}`, true, ""},
+		{"Value differs", `{"items": [{"name": "pear"}]}`, false,
+			"JSON does not contain the partial document (actual != expected):\n" +
+				"  $.items[0].name: \"apple\" != \"pear\""},
+		{"Missing", `{"owner": {"last name": "Lovelace"}, "items": [{}, {}, {}]}`, false,
+			"JSON does not contain the partial document (actual != expected):\n" +
+				"  $.items[2]: does not exist, nearest existing path $.items is an array of 2 elements\n" +
+				"  $.owner[\"last name\"]: does not exist, nearest existing path $.owner is " +
+				"an object with members \"first name\""},
+		{"Type differs", `{"owner": []}`, false,
+			"JSON does not contain the partial document (actual != expected):\n" +
+				"  $.owner: {\"first name\":\"Ada\"} != []"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := JSONContains(mockT, jsonPathDoc, tc.partial)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Matcher decides whether an actual value is acceptable. Match returns
+// whether the value matched along with a description of the outcome,
+// which is reported when a check fails.
+type Matcher interface {
+	Match(actual any) (bool, string)
+}
+
+// MatcherFunc adapts an ordinary function to the Matcher interface.
+type MatcherFunc func(actual any) (bool, string)
+
+// Match calls f(actual).
+func (f MatcherFunc) Match(actual any) (bool, string) {
+	return f(actual)
+}
+
+// Matches checks whether the actual value satisfies the matcher. On failure
+// it logs the matcher's description of why the value did not match.
+func Matches(t checkmate.TestingT, actual any, m Matcher, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	matched, description := m.Match(actual)
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"value %v did not match:\n%s", actual, indent(description)}
+	}
+
+	return check(t, matched, msgAndArgs...)
+}
+
+// EqualTo matches values that are equal to expected using ==.
+func EqualTo(expected any) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		equal, problem := compareValues(actual, expected)
+		if problem != "" {
+			return false, problem
+		}
+		if equal {
+			return true, fmt.Sprintf("%v equals %v", actual, expected)
+		}
+		if !sameType(actual, expected) {
+			return false, fmt.Sprintf(
+				"%s does not equal %s, the types differ", describeValue(actual), describeValue(expected),
+			)
+		}
+		return false, fmt.Sprintf("%v does not equal %v", actual, expected)
+	})
+}
+
+// DeepEqualTo matches values that are deeply equal to expected, using the
+// same comparison and options as DeepEqual.
+func DeepEqualTo(expected any, opts ...CompareOption) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		equal, diff, err := newCompareConfig(opts...).compare(expected, actual)
+		if err != nil {
+			return false, fmt.Sprintf("cannot compare the values: %v", err)
+		}
+		if equal {
+			return true, fmt.Sprintf("%v deeply equals %v", actual, expected)
+		}
+		return false, fmt.Sprintf("values differ (-expected +actual):\n%s", diff)
+	})
+}
+
+// IsNil matches nil values, including nil pointers.
+func IsNil() Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		if isNil(actual) {
+			return true, fmt.Sprintf("%v is nil", actual)
+		}
+		return false, fmt.Sprintf("%v is not nil", actual)
+	})
+}
+
+// IsTrue matches the bool value true.
+func IsTrue() Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		condition, isBool := actual.(bool)
+		if !isBool {
+			return false, fmt.Sprintf("%T is not a bool", actual)
+		}
+		return condition, fmt.Sprintf("value is %v", condition)
+	})
+}
+
+// IsFalse matches the bool value false.
+func IsFalse() Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		condition, isBool := actual.(bool)
+		if !isBool {
+			return false, fmt.Sprintf("%T is not a bool", actual)
+		}
+		return !condition, fmt.Sprintf("value is %v", condition)
+	})
+}
+
+// WrapsError matches errors that have target within their error tree.
+func WrapsError(target error) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		err, isErr := actual.(error)
+		if !isErr && actual != nil {
+			return false, fmt.Sprintf("%T is not an error", actual)
+		}
+		if errors.Is(err, target) {
+			return true, fmt.Sprintf("error %v has %v in its tree", err, target)
+		}
+		return false, fmt.Sprintf("error %v does not have %v in its tree", err, target)
+	})
+}
+
+// ErrorContaining matches errors whose Error() output contains errText.
+func ErrorContaining(errText string) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		err, isErr := actual.(error)
+		if !isErr || err == nil {
+			return false, fmt.Sprintf("%v is not a non-nil error", actual)
+		}
+		if strings.Contains(err.Error(), errText) {
+			return true, fmt.Sprintf("error %q contains %q", err.Error(), errText)
+		}
+		return false, fmt.Sprintf("error %q does not contain %q", err.Error(), errText)
+	})
+}
+
+// Not matches values that the given matcher does not match.
+func Not(m Matcher) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		matched, description := m.Match(actual)
+		return !matched, "not:\n" + indent(description)
+	})
+}
+
+// AllOf matches values that every one of the given matchers matches. All
+// matchers are evaluated so the description lists each outcome.
+func AllOf(matchers ...Matcher) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		matchedCount, description := matchAll(actual, matchers)
+		return matchedCount == len(matchers), fmt.Sprintf(
+			"all of (%d/%d matched):\n%s", matchedCount, len(matchers), description,
+		)
+	})
+}
+
+// AnyOf matches values that at least one of the given matchers matches. All
+// matchers are evaluated so the description lists each outcome.
+func AnyOf(matchers ...Matcher) Matcher {
+	return MatcherFunc(func(actual any) (bool, string) {
+		matchedCount, description := matchAll(actual, matchers)
+		return matchedCount > 0, fmt.Sprintf(
+			"any of (%d/%d matched):\n%s", matchedCount, len(matchers), description,
+		)
+	})
+}
+
+// matchAll runs every matcher against actual and returns how many matched
+// along with an indented, marked list of their descriptions.
+func matchAll(actual any, matchers []Matcher) (int, string) {
+	matchedCount := 0
+	lines := make([]string, 0, len(matchers))
+	for _, m := range matchers {
+		matched, description := m.Match(actual)
+		marker := "✘ "
+		if matched {
+			matchedCount++
+			marker = "✔ "
+		}
+		// Continuation lines are indented past the marker so nested
+		// descriptions line up under their parent's text.
+		lines = append(lines, indent(marker+strings.ReplaceAll(description, "\n", "\n  ")))
+	}
+	return matchedCount, strings.Join(lines, "\n")
+}
+
+// indent prefixes every line of s with two spaces.
+func indent(s string) string {
+	return "  " + strings.ReplaceAll(s, "\n", "\n  ")
+}
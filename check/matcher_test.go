@@ -0,0 +1,134 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestBuiltInMatchers(t *testing.T) {
+	testCases := []struct {
+		name        string
+		matcher     Matcher
+		actual      any
+		shouldMatch bool
+		description string
+	}{
+		{"EqualTo match", EqualTo(5), 5, true, "5 equals 5"},
+		{"EqualTo mismatch", EqualTo(10), 5, false, "5 does not equal 10"},
+		{"EqualTo non-comparable", EqualTo([]int{1}), []int{1}, false, "cannot compare []int values with =="},
+		{"EqualTo type mismatch", EqualTo(5), int32(5), false, "int32(5) does not equal int(5), the types differ"},
+		{"IsNil match", IsNil(), nil, true, "<nil> is nil"},
+		{"IsNil mismatch", IsNil(), 1, false, "1 is not nil"},
+		{"IsTrue match", IsTrue(), true, true, "value is true"},
+		{"IsTrue non-bool", IsTrue(), 1, false, "int is not a bool"},
+		{"IsFalse match", IsFalse(), false, true, "value is false"},
+		{
+			"WrapsError match",
+			WrapsError(os.ErrExist), fmt.Errorf("wrapped: %w", os.ErrExist), true,
+			"error wrapped: file already exists has file already exists in its tree",
+		},
+		{
+			"ErrorContaining mismatch",
+			ErrorContaining("moon"), errors.New("hello world"), false,
+			`error "hello world" does not contain "moon"`,
+		},
+		{"ErrorContaining nil", ErrorContaining("moon"), nil, false, "<nil> is not a non-nil error"},
+		{"Not", Not(EqualTo(5)), 5, false, "not:\n  5 equals 5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, description := tc.matcher.Match(tc.actual)
+
+			if matched != tc.shouldMatch {
+				t.Errorf("%s: matched = %v, want %v", tc.name, matched, tc.shouldMatch)
+			}
+			if description != tc.description {
+				t.Errorf("%s: expected description '%s', got '%s'", tc.name, tc.description, description)
+			}
+		})
+	}
+}
+
+func TestDeepEqualToDescribesDiff(t *testing.T) {
+	matched, description := DeepEqualTo([]int{1, 2}).Match([]int{1, 3})
+
+	if matched {
+		t.Fatal("DeepEqualTo matched values that differ")
+	}
+	if !containsDiffMessage(description) {
+		t.Errorf("expected a diff in the description, got '%s'", description)
+	}
+}
+
+func TestCombinatorsProduceNestedDescriptions(t *testing.T) {
+	matcher := AllOf(
+		EqualTo(5),
+		AnyOf(EqualTo(6), Not(IsNil())),
+		Not(EqualTo(5)),
+	)
+
+	matched, description := matcher.Match(5)
+
+	if matched {
+		t.Fatal("AllOf matched although one of its matchers did not")
+	}
+	expected := strings.Join([]string{
+		"all of (2/3 matched):",
+		"  ✔ 5 equals 5",
+		"  ✔ any of (1/2 matched):",
+		"      ✘ 5 does not equal 6",
+		"      ✔ not:",
+		"          5 is not nil",
+		"  ✘ not:",
+		"      5 equals 5",
+	}, "\n")
+	if description != expected {
+		t.Errorf("expected description:\n%s\ngot:\n%s", expected, description)
+	}
+}
+
+func TestAnyOfWithNoMatches(t *testing.T) {
+	matched, _ := AnyOf(EqualTo(1), EqualTo(2)).Match(3)
+
+	if matched {
+		t.Fatal("AnyOf matched although none of its matchers did")
+	}
+}
+
+func TestMatchesLogsDescription(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	passed := Matches(mockT, 5, AllOf(EqualTo(5), EqualTo(6)))
+
+	if passed || !mockT.FailCalled {
+		t.Fatal("Matches should have failed")
+	}
+	expected := "value 5 did not match:\n" +
+		"  all of (1/2 matched):\n" +
+		"    ✔ 5 equals 5\n" +
+		"    ✘ 5 does not equal 6"
+	if len(mockT.Logs) != 1 || mockT.Logs[0] != expected {
+		t.Errorf("expected log message '%s', got %v", expected, mockT.Logs)
+	}
+}
+
+func TestMatcherFuncAllowsCustomMatchers(t *testing.T) {
+	isEven := MatcherFunc(func(actual any) (bool, string) {
+		n, ok := actual.(int)
+		if ok && n%2 == 0 {
+			return true, fmt.Sprintf("%d is even", n)
+		}
+		return false, fmt.Sprintf("%v is not an even int", actual)
+	})
+	mockT := &cmtest.MockT{}
+
+	if !Matches(mockT, 4, Not(Not(isEven))) {
+		t.Fatalf("custom matcher should have matched 4, logs: %v", mockT.Logs)
+	}
+}
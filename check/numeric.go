@@ -0,0 +1,262 @@
+package check
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// InDelta checks whether actual is within delta of expected, i.e. that
+// |actual - expected| <= delta.
+//
+// Two NaNs are considered within any delta of each other, while a NaN is
+// never within delta of a number. Infinities are only within delta of an
+// infinity with the same sign. A negative or NaN delta fails the check.
+func InDelta[T Number](t checkmate.TestingT, actual, expected, delta T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if invalidTolerance(float64(delta)) {
+		return check(t, false, "invalid delta %v, it must be a non-negative number", delta)
+	}
+
+	within, actualDelta := withinDelta(float64(actual), float64(expected), float64(delta))
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected %v to be within %v of %v, actual delta is %v", actual, delta, expected, actualDelta,
+		}
+	}
+
+	return check(t, within, msgAndArgs...)
+}
+
+// InEpsilon checks whether the relative error between actual and expected,
+// |actual - expected| / |expected|, is at most epsilon. When expected is
+// zero the relative error is undefined, so only an actual of zero passes.
+// NaN and infinities follow the same rules as InDelta.
+func InEpsilon[T Number](t checkmate.TestingT, actual, expected T, epsilon float64, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if invalidTolerance(epsilon) {
+		return check(t, false, "invalid epsilon %v, it must be a non-negative number", epsilon)
+	}
+
+	a, e := float64(actual), float64(expected)
+	var within bool
+	var relativeError float64
+	switch {
+	case math.IsNaN(a) || math.IsNaN(e) || math.IsInf(a, 0) || math.IsInf(e, 0):
+		within, relativeError = withinDelta(a, e, 0)
+	case e == 0:
+		within, relativeError = a == 0, math.Inf(1)
+		if within {
+			relativeError = 0
+		}
+	default:
+		relativeError = math.Abs(a-e) / math.Abs(e)
+		within = relativeError <= epsilon
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected %v to be within relative error %v of %v, actual relative error is %v",
+			actual, epsilon, expected, relativeError,
+		}
+	}
+
+	return check(t, within, msgAndArgs...)
+}
+
+// InULPs checks whether actual is at most maxULPs representable floating
+// point values away from expected. Zero and negative zero are 0 ULPs apart.
+// NaN follows the same rules as InDelta.
+func InULPs[T Float](t checkmate.TestingT, actual, expected T, maxULPs uint64, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	a, e := float64(actual), float64(expected)
+	var within bool
+	var distance uint64
+	if math.IsNaN(a) || math.IsNaN(e) {
+		within, _ = withinDelta(a, e, 0)
+		distance = math.MaxUint64
+	} else {
+		if reflect.TypeOf(actual).Kind() == reflect.Float32 {
+			distance = ulpDistance32(float32(actual), float32(expected))
+		} else {
+			distance = ulpDistance64(a, e)
+		}
+		within = distance <= maxULPs
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected %v to be within %d ULPs of %v, actual distance is %d ULPs", actual, maxULPs, expected, distance,
+		}
+	}
+
+	return check(t, within, msgAndArgs...)
+}
+
+// InDeltaSlice checks whether both slices have the same length and every
+// element of actual is within delta of the element of expected at the same
+// index, following the rules of InDelta.
+func InDeltaSlice[T Number](t checkmate.TestingT, actual, expected []T, delta T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if invalidTolerance(float64(delta)) {
+		return check(t, false, "invalid delta %v, it must be a non-negative number", delta)
+	}
+	if len(actual) != len(expected) {
+		return check(t, false, "expected slices of the same length, got %d and %d", len(actual), len(expected))
+	}
+
+	var outOfTolerance []string
+	for i := range actual {
+		within, actualDelta := withinDelta(float64(actual[i]), float64(expected[i]), float64(delta))
+		if !within {
+			outOfTolerance = append(outOfTolerance, fmt.Sprintf(
+				"  [%d]: %v vs %v, delta %v", i, actual[i], expected[i], actualDelta,
+			))
+		}
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected every element to be within %v, out of tolerance (actual vs expected):\n%s",
+			delta, strings.Join(outOfTolerance, "\n"),
+		}
+	}
+
+	return check(t, len(outOfTolerance) == 0, msgAndArgs...)
+}
+
+// InDeltaMapValues checks whether both maps have the same keys and every
+// value of actual is within delta of the value of expected under the same
+// key, following the rules of InDelta.
+func InDeltaMapValues[K comparable, V Number](
+	t checkmate.TestingT, actual, expected map[K]V, delta V, msgAndArgs ...any,
+) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if invalidTolerance(float64(delta)) {
+		return check(t, false, "invalid delta %v, it must be a non-negative number", delta)
+	}
+
+	var problems []string
+	for key, expectedValue := range expected {
+		actualValue, ok := actual[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("  [%v]: missing, expected %v", key, expectedValue))
+			continue
+		}
+		within, actualDelta := withinDelta(float64(actualValue), float64(expectedValue), float64(delta))
+		if !within {
+			problems = append(problems, fmt.Sprintf(
+				"  [%v]: %v vs %v, delta %v", key, actualValue, expectedValue, actualDelta,
+			))
+		}
+	}
+	for key, actualValue := range actual {
+		if _, ok := expected[key]; !ok {
+			problems = append(problems, fmt.Sprintf("  [%v]: unexpected, got %v", key, actualValue))
+		}
+	}
+	sort.Strings(problems)
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected every value to be within %v, mismatched entries (actual vs expected):\n%s",
+			delta, strings.Join(problems, "\n"),
+		}
+	}
+
+	return check(t, len(problems) == 0, msgAndArgs...)
+}
+
+// invalidTolerance reports whether a delta or epsilon cannot be used.
+func invalidTolerance(tolerance float64) bool {
+	return tolerance < 0 || math.IsNaN(tolerance)
+}
+
+// withinDelta reports whether actual is within delta of expected along with
+// the absolute difference between them.
+func withinDelta(actual, expected, delta float64) (bool, float64) {
+	switch {
+	case math.IsNaN(actual) && math.IsNaN(expected):
+		return true, 0
+	case math.IsNaN(actual) || math.IsNaN(expected):
+		return false, math.NaN()
+	case math.IsInf(actual, 0) || math.IsInf(expected, 0):
+		if actual == expected {
+			return true, 0
+		}
+		return false, math.Inf(1)
+	}
+
+	difference := math.Abs(actual - expected)
+	return difference <= delta, difference
+}
+
+// ulpDistance64 returns how many representable float64 values lie between
+// a and b.
+func ulpDistance64(a, b float64) uint64 {
+	orderedA, orderedB := orderedBits64(a), orderedBits64(b)
+	if orderedA > orderedB {
+		return uint64(orderedA - orderedB)
+	}
+	return uint64(orderedB - orderedA)
+}
+
+// orderedBits64 maps a float64 to an integer such that adjacent floats map
+// to adjacent integers, with negative zero and zero both mapping to 0.
+func orderedBits64(f float64) int64 {
+	bits := math.Float64bits(f)
+	if bits>>63 == 1 {
+		return -int64(bits &^ (1 << 63))
+	}
+	return int64(bits)
+}
+
+// ulpDistance32 returns how many representable float32 values lie between
+// a and b.
+func ulpDistance32(a, b float32) uint64 {
+	orderedA, orderedB := orderedBits32(a), orderedBits32(b)
+	if orderedA > orderedB {
+		return uint64(orderedA - orderedB)
+	}
+	return uint64(orderedB - orderedA)
+}
+
+// orderedBits32 is the float32 counterpart to orderedBits64.
+func orderedBits32(f float32) int64 {
+	bits := math.Float32bits(f)
+	if bits>>31 == 1 {
+		return -int64(bits &^ (1 << 31))
+	}
+	return int64(bits)
+}
@@ -0,0 +1,147 @@
+package check
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestNumericToleranceChecks(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"InDelta ints", func(t checkmate.TestingT) bool { return InDelta(t, 10, 12, 2) }, true, ""},
+		{"InDelta unsigned", func(t checkmate.TestingT) bool { return InDelta[uint](t, 10, 12, 1) }, false,
+			"expected 10 to be within 1 of 12, actual delta is 2"},
+		{"InDelta float32", func(t checkmate.TestingT) bool { return InDelta[float32](t, 1.5, 1.25, 0.25) }, true, ""},
+		{"InDelta both NaN", func(t checkmate.TestingT) bool { return InDelta(t, nan, nan, 0.1) }, true, ""},
+		{"InDelta one NaN", func(t checkmate.TestingT) bool { return InDelta(t, nan, 1, 0.1) }, false,
+			"expected NaN to be within 0.1 of 1, actual delta is NaN"},
+		{"InDelta same infinities", func(t checkmate.TestingT) bool { return InDelta(t, inf, inf, 0) }, true, ""},
+		{"InDelta opposite infinities", func(t checkmate.TestingT) bool { return InDelta(t, inf, -inf, 1) }, false,
+			"expected +Inf to be within 1 of -Inf, actual delta is +Inf"},
+		{"InDelta negative delta", func(t checkmate.TestingT) bool { return InDelta(t, 1.0, 1.0, -1) }, false,
+			"invalid delta -1, it must be a non-negative number"},
+		{"InEpsilon", func(t checkmate.TestingT) bool { return InEpsilon(t, 95, 100, 0.01) }, false,
+			"expected 95 to be within relative error 0.01 of 100, actual relative error is 0.05"},
+		{"InEpsilon zero expected", func(t checkmate.TestingT) bool { return InEpsilon(t, 0.0, 0.0, 0.01) }, true, ""},
+		{"InEpsilon zero expected with non-zero actual",
+			func(t checkmate.TestingT) bool { return InEpsilon(t, 0.5, 0.0, 0.01) }, false,
+			"expected 0.5 to be within relative error 0.01 of 0, actual relative error is +Inf"},
+		{"InEpsilon invalid", func(t checkmate.TestingT) bool { return InEpsilon(t, 1, 1, nan) }, false,
+			"invalid epsilon NaN, it must be a non-negative number"},
+		{"InULPs adjacent", func(t checkmate.TestingT) bool { return InULPs(t, 1.0, math.Nextafter(1, 2), 1) }, true, ""},
+		{"InULPs across zero",
+			func(t checkmate.TestingT) bool {
+				return InULPs(t, math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, 1)
+			}, false,
+			"expected 5e-324 to be within 1 ULPs of -5e-324, actual distance is 2 ULPs"},
+		{"InULPs signed zeros", func(t checkmate.TestingT) bool { return InULPs(t, 0.0, math.Copysign(0, -1), 0) }, true, ""},
+		{"InULPs float32",
+			func(t checkmate.TestingT) bool { return InULPs(t, float32(1), math.Nextafter32(1, 2), 0) }, false,
+			"expected 1 to be within 0 ULPs of 1.0000001, actual distance is 1 ULPs"},
+		{"InDeltaSlice lengths",
+			func(t checkmate.TestingT) bool { return InDeltaSlice(t, []int{1}, []int{1, 2}, 0) }, false,
+			"expected slices of the same length, got 1 and 2"},
+		{"InDeltaSlice values",
+			func(t checkmate.TestingT) bool { return InDeltaSlice(t, []float64{1, 2, 3}, []float64{1.5, 2, 4}, 0.1) },
+			false,
+			"expected every element to be within 0.1, out of tolerance (actual vs expected):\n" +
+				"  [0]: 1 vs 1.5, delta 0.5\n" +
+				"  [2]: 3 vs 4, delta 1"},
+		{"InDeltaMapValues",
+			func(t checkmate.TestingT) bool {
+				return InDeltaMapValues(t, map[string]int{"a": 1, "b": 5, "c": 3}, map[string]int{"a": 2, "b": 1, "d": 4}, 1)
+			},
+			false,
+			"expected every value to be within 1, mismatched entries (actual vs expected):\n" +
+				"  [b]: 5 vs 1, delta 4\n" +
+				"  [c]: unexpected, got 3\n" +
+				"  [d]: missing, expected 4"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestDeepEqualWithFloatTolerance(t *testing.T) {
+	type reading struct {
+		Sensor string
+		Values []float64
+	}
+	type calibrated struct {
+		Reading reading
+		offset  float32
+	}
+
+	t.Run("Nested floats within tolerance", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(
+			mockT,
+			reading{"a", []float64{1.0001, math.NaN()}},
+			reading{"a", []float64{1, math.NaN()}},
+			FloatTolerance(0.001),
+		)
+
+		if !passed {
+			t.Fatalf("DeepEqual should have passed within tolerance, logs: %v", mockT.Logs)
+		}
+	})
+
+	t.Run("Nested floats out of tolerance", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(mockT, reading{"a", []float64{1.1}}, reading{"a", []float64{1}}, FloatTolerance(0.001))
+
+		if passed {
+			t.Fatal("DeepEqual should have failed out of tolerance")
+		}
+	})
+
+	t.Run("Reflection fallback", func(t *testing.T) {
+		mockT := &cmtest.MockT{}
+
+		passed := DeepEqual(
+			mockT,
+			calibrated{reading{"a", []float64{1.0001}}, 0.5001},
+			calibrated{reading{"a", []float64{1}}, 0.5},
+			FloatTolerance(0.001),
+		)
+		if !passed {
+			t.Fatalf("DeepEqual should have passed within tolerance, logs: %v", mockT.Logs)
+		}
+
+		passed = DeepEqual(
+			mockT,
+			calibrated{reading{"a", []float64{1}}, 0.6},
+			calibrated{reading{"a", []float64{1}}, 0.5},
+			FloatTolerance(0.001),
+		)
+		if passed || len(mockT.Logs) != 1 || !strings.Contains(mockT.Logs[0], ".offset: 0.5") {
+			t.Fatalf("DeepEqual should have reported the offset, logs: %v", mockT.Logs)
+		}
+	})
+}
@@ -0,0 +1,174 @@
+package check
+
+import (
+	"cmp"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Greater checks whether actual is strictly greater than bound.
+func Greater[T cmp.Ordered](t checkmate.TestingT, actual, bound T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be greater than %v", actual, bound}
+	}
+
+	return check(t, actual > bound, msgAndArgs...)
+}
+
+// GreaterOrEqual checks whether actual is greater than or equal to bound.
+func GreaterOrEqual[T cmp.Ordered](t checkmate.TestingT, actual, bound T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be greater than or equal to %v", actual, bound}
+	}
+
+	return check(t, actual >= bound, msgAndArgs...)
+}
+
+// Less checks whether actual is strictly less than bound.
+func Less[T cmp.Ordered](t checkmate.TestingT, actual, bound T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be less than %v", actual, bound}
+	}
+
+	return check(t, actual < bound, msgAndArgs...)
+}
+
+// LessOrEqual checks whether actual is less than or equal to bound.
+func LessOrEqual[T cmp.Ordered](t checkmate.TestingT, actual, bound T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be less than or equal to %v", actual, bound}
+	}
+
+	return check(t, actual <= bound, msgAndArgs...)
+}
+
+// Between checks whether actual lies within the inclusive range [low, high].
+func Between[T cmp.Ordered](t checkmate.TestingT, actual, low, high T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if low > high {
+		return check(t, false, "invalid range, low %v is greater than high %v", low, high)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be between %v and %v inclusive", actual, low, high}
+	}
+
+	return check(t, low <= actual && actual <= high, msgAndArgs...)
+}
+
+// Positive checks whether actual is strictly greater than zero.
+func Positive[T Number](t checkmate.TestingT, actual T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be positive", actual}
+	}
+
+	return check(t, actual > 0, msgAndArgs...)
+}
+
+// Negative checks whether actual is strictly less than zero.
+func Negative[T Number](t checkmate.TestingT, actual T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %v to be negative", actual}
+	}
+
+	return check(t, actual < 0, msgAndArgs...)
+}
+
+// IsSorted checks whether the slice is sorted in ascending order, allowing
+// equal neighbours. Elements are ordered with cmp.Compare, so NaNs sort
+// first. On failure it reports the first out-of-order index.
+func IsSorted[T cmp.Ordered](t checkmate.TestingT, values []T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return IsSortedFunc(t, values, cmp.Compare[T], msgAndArgs...)
+}
+
+// IsSortedFunc checks whether the slice is sorted in ascending order as
+// defined by compare, which returns a negative number when a < b, zero when
+// a == b, and a positive number when a > b.
+func IsSortedFunc[T any](t checkmate.TestingT, values []T, compare func(a, b T) int, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	index := firstOutOfOrder(values, func(previous, current T) bool {
+		return compare(previous, current) <= 0
+	})
+	if index == -1 {
+		return check(t, true)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected slice to be sorted, element [%d] %v is less than element [%d] %v",
+			index, values[index], index - 1, values[index-1],
+		}
+	}
+
+	return check(t, false, msgAndArgs...)
+}
+
+// IsStrictlyIncreasing checks whether every element of the slice is
+// strictly greater than the one before it. On failure it reports the first
+// out-of-order index.
+func IsStrictlyIncreasing[T cmp.Ordered](t checkmate.TestingT, values []T, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	index := firstOutOfOrder(values, func(previous, current T) bool {
+		return cmp.Less(previous, current)
+	})
+	if index == -1 {
+		return check(t, true)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected slice to be strictly increasing, element [%d] %v is not greater than element [%d] %v",
+			index, values[index], index - 1, values[index-1],
+		}
+	}
+
+	return check(t, false, msgAndArgs...)
+}
+
+// firstOutOfOrder returns the first index i for which inOrder(values[i-1],
+// values[i]) is false, or -1 if there is none.
+func firstOutOfOrder[T any](values []T, inOrder func(previous, current T) bool) int {
+	for i := 1; i < len(values); i++ {
+		if !inOrder(values[i-1], values[i]) {
+			return i
+		}
+	}
+	return -1
+}
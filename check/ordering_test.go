@@ -0,0 +1,73 @@
+package check
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestOrderingChecks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"Greater strings", func(t checkmate.TestingT) bool { return Greater(t, "b", "a") }, true, ""},
+		{"Greater", func(t checkmate.TestingT) bool { return Greater(t, 1.5, 2) }, false,
+			"expected 1.5 to be greater than 2"},
+		{"Greater NaN", func(t checkmate.TestingT) bool { return Greater(t, math.NaN(), 0) }, false,
+			"expected NaN to be greater than 0"},
+		{"GreaterOrEqual", func(t checkmate.TestingT) bool { return GreaterOrEqual(t, 1, 2) }, false,
+			"expected 1 to be greater than or equal to 2"},
+		{"Less", func(t checkmate.TestingT) bool { return Less(t, "b", "a") }, false,
+			"expected b to be less than a"},
+		{"LessOrEqual", func(t checkmate.TestingT) bool { return LessOrEqual(t, 3, 2) }, false,
+			"expected 3 to be less than or equal to 2"},
+		{"Between bounds", func(t checkmate.TestingT) bool { return Between(t, 3, 1, 3) }, true, ""},
+		{"Between", func(t checkmate.TestingT) bool { return Between(t, 0, 1, 3) }, false,
+			"expected 0 to be between 1 and 3 inclusive"},
+		{"Between invalid range", func(t checkmate.TestingT) bool { return Between(t, 2, 3, 1) }, false,
+			"invalid range, low 3 is greater than high 1"},
+		{"Positive", func(t checkmate.TestingT) bool { return Positive(t, -0.5) }, false,
+			"expected -0.5 to be positive"},
+		{"Negative unsigned", func(t checkmate.TestingT) bool { return Negative[uint](t, 0) }, false,
+			"expected 0 to be negative"},
+		{"IsSorted empty", func(t checkmate.TestingT) bool { return IsSorted[int](t, nil) }, true, ""},
+		{"IsSorted", func(t checkmate.TestingT) bool { return IsSorted(t, []int{1, 2, 2, 1, 0}) }, false,
+			"expected slice to be sorted, element [3] 1 is less than element [2] 2"},
+		{"IsSortedFunc descending",
+			func(t checkmate.TestingT) bool {
+				return IsSortedFunc(t, []string{"c", "b", "a"}, func(a, b string) int { return strings.Compare(b, a) })
+			}, true, ""},
+		{"IsSortedFunc by length",
+			func(t checkmate.TestingT) bool {
+				return IsSortedFunc(t, []string{"a", "ccc", "bb"}, func(a, b string) int { return len(a) - len(b) })
+			}, false,
+			"expected slice to be sorted, element [2] bb is less than element [1] ccc"},
+		{"IsStrictlyIncreasing", func(t checkmate.TestingT) bool { return IsStrictlyIncreasing(t, []int{1, 2, 2}) },
+			false,
+			"expected slice to be strictly increasing, element [2] 2 is not greater than element [1] 2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime/debug"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// Panics checks whether calling fn panics.
+func Panics(t checkmate.TestingT, fn func(), msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	panicked, _, _ := capturePanic(fn)
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected function to panic, but it returned normally"}
+	}
+
+	return check(t, panicked, msgAndArgs...)
+}
+
+// NotPanics checks whether calling fn returns without panicking. On failure
+// it logs the recovered value and the stack of the panicking goroutine.
+func NotPanics(t checkmate.TestingT, fn func(), msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	panicked, value, stack := capturePanic(fn)
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected function to not panic, but it panicked with %v\n%s", value, stack}
+	}
+
+	return check(t, !panicked, msgAndArgs...)
+}
+
+// PanicsWithValue checks whether calling fn panics with a value that is
+// deeply equal to expected.
+func PanicsWithValue(t checkmate.TestingT, fn func(), expected any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	panicked, value, stack := capturePanic(fn)
+	if !panicked {
+		return check(t, false, "expected function to panic with %v, but it returned normally", expected)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected function to panic with %v, but it panicked with %v\n%s", expected, value, stack,
+		}
+	}
+
+	return check(t, reflect.DeepEqual(value, expected), msgAndArgs...)
+}
+
+// PanicsWithError checks whether calling fn panics with an error that has
+// target within its error tree, as reported by errors.Is.
+func PanicsWithError(t checkmate.TestingT, fn func(), target error, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	panicked, value, stack := capturePanic(fn)
+	if !panicked {
+		return check(t, false, "expected function to panic with error %v, but it returned normally", target)
+	}
+
+	err, isErr := value.(error)
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected function to panic with error %v, but it panicked with %v (%T)\n%s",
+			target, value, value, stack,
+		}
+	}
+
+	return check(t, isErr && errors.Is(err, target), msgAndArgs...)
+}
+
+// PanicsMatching checks whether calling fn panics with a value whose
+// formatted text matches the regular expression pattern.
+func PanicsMatching(t checkmate.TestingT, fn func(), pattern string, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return check(t, false, "invalid pattern %q: %v", pattern, err)
+	}
+
+	panicked, value, stack := capturePanic(fn)
+	if !panicked {
+		return check(t, false, "expected function to panic matching %q, but it returned normally", pattern)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected function to panic matching %q, but it panicked with %v\n%s", pattern, value, stack,
+		}
+	}
+
+	return check(t, re.MatchString(fmt.Sprint(value)), msgAndArgs...)
+}
+
+// capturePanic calls fn and reports whether it panicked, the recovered value,
+// and the stack of the panicking goroutine at the point of the panic.
+func capturePanic(fn func()) (panicked bool, value any, stack string) {
+	panicked = true
+	defer func() {
+		if panicked {
+			value = recover()
+			stack = "panic stack:\n" + string(debug.Stack())
+		}
+	}()
+
+	fn()
+	panicked = false
+	return panicked, value, stack
+}
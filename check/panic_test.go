@@ -0,0 +1,131 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func panicWithBoom() {
+	panic("boom")
+}
+
+func TestPanicChecks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logPrefix  string
+	}{
+		{
+			"Panics returned normally",
+			func(t checkmate.TestingT) bool { return Panics(t, func() {}) },
+			false, "expected function to panic, but it returned normally",
+		},
+		{
+			"Panics with nil",
+			func(t checkmate.TestingT) bool { return Panics(t, func() { panic(nil) }) },
+			true, "",
+		},
+		{
+			"NotPanics logs value",
+			func(t checkmate.TestingT) bool { return NotPanics(t, panicWithBoom) },
+			false, "expected function to not panic, but it panicked with boom\npanic stack:\n",
+		},
+		{
+			"PanicsWithValue struct",
+			func(t checkmate.TestingT) bool {
+				type reason struct{ Code int }
+				return PanicsWithValue(t, func() { panic(reason{1}) }, reason{1})
+			},
+			true, "",
+		},
+		{
+			"PanicsWithValue returned normally",
+			func(t checkmate.TestingT) bool { return PanicsWithValue(t, func() {}, "boom") },
+			false, "expected function to panic with boom, but it returned normally",
+		},
+		{
+			"PanicsWithValue wrong value",
+			func(t checkmate.TestingT) bool { return PanicsWithValue(t, panicWithBoom, "bang") },
+			false, "expected function to panic with bang, but it panicked with boom\npanic stack:\n",
+		},
+		{
+			"PanicsWithError wrapped error",
+			func(t checkmate.TestingT) bool {
+				return PanicsWithError(t, func() { panic(fmt.Errorf("wrapped: %w", os.ErrClosed)) }, os.ErrClosed)
+			},
+			true, "",
+		},
+		{
+			"PanicsWithError non-error value",
+			func(t checkmate.TestingT) bool { return PanicsWithError(t, panicWithBoom, os.ErrClosed) },
+			false, "expected function to panic with error file already closed, but it panicked with boom (string)\n",
+		},
+		{
+			"PanicsWithError returned normally",
+			func(t checkmate.TestingT) bool { return PanicsWithError(t, func() {}, os.ErrClosed) },
+			false, "expected function to panic with error file already closed, but it returned normally",
+		},
+		{
+			"PanicsMatching error value",
+			func(t checkmate.TestingT) bool {
+				return PanicsMatching(t, func() { panic(errors.New("index 7 out of range")) }, `index \d+`)
+			},
+			true, "",
+		},
+		{
+			"PanicsMatching runtime error",
+			func(t checkmate.TestingT) bool {
+				return PanicsMatching(t, func() {
+					var values []int
+					_ = values[3]
+				}, `index out of range`)
+			},
+			true, "",
+		},
+		{
+			"PanicsMatching invalid pattern",
+			func(t checkmate.TestingT) bool { return PanicsMatching(t, panicWithBoom, `(`) },
+			false, `invalid pattern "(": error parsing regexp`,
+		},
+		{
+			"PanicsMatching returned normally",
+			func(t checkmate.TestingT) bool { return PanicsMatching(t, func() {}, `boom`) },
+			false, `expected function to panic matching "boom", but it returned normally`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || !strings.HasPrefix(mockT.Logs[0], tc.logPrefix)) {
+				t.Errorf("%s: expected log message starting with '%s', got %v", tc.name, tc.logPrefix, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestPanicChecksLogPanickingStack(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	NotPanics(mockT, panicWithBoom)
+
+	if len(mockT.Logs) != 1 || !strings.Contains(mockT.Logs[0], "check.panicWithBoom") {
+		t.Errorf("expected the log message to include the panicking function, got %v", mockT.Logs)
+	}
+}
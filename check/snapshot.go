@@ -0,0 +1,574 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/update"
+)
+
+// snapshotDir is the directory snapshot files are kept in, relative to the
+// package under test.
+var snapshotDir = filepath.Join("testdata", "__snapshots__")
+
+// snapshotFileHeader starts every snapshot file. Lines before the first
+// entry are ignored when the file is read.
+const snapshotFileHeader = "# Snapshots written by checkmate. Run the tests with -checkmate.update to regenerate.\n"
+
+// snapshots caches the snapshot files read while the tests run and records
+// which entries were used so VerifySnapshots can find the obsolete ones.
+var snapshots = struct {
+	mu    sync.Mutex
+	files map[string]*snapshotFile
+	// used holds the entry keys matched in each snapshot file.
+	used map[string]map[string]bool
+	// counts holds the number of snapshots taken so far by each running
+	// test.
+	counts map[string]int
+}{
+	files:  map[string]*snapshotFile{},
+	used:   map[string]map[string]bool{},
+	counts: map[string]int{},
+}
+
+// snapshotT is a TestingT which knows the name of the running test and can
+// run code once it finishes.
+type snapshotT interface {
+	Name() string
+	Cleanup(func())
+}
+
+// MatchSnapshot checks whether value matches the snapshot stored for it.
+// The value is serialized deterministically: map keys are sorted, pointers
+// are rendered as the value they point to rather than an address, and
+// cycles are marked instead of followed.
+//
+// Snapshots live in testdata/__snapshots__/<TestName>.snap, one file per
+// top-level test, keyed by the full test name and the order of the call
+// within the test. A missing snapshot is written and the check passes,
+// unless the CI environment variable is true, in which case it fails.
+//
+// On a mismatch the diff is reported and the would-be file is written next
+// to the snapshot file with a .new suffix for review. Running the tests
+// with -checkmate.update or CHECKMATE_UPDATE=1 accepts every new value.
+//
+// t must have the Name and Cleanup methods, as *testing.T does.
+func MatchSnapshot(t checkmate.TestingT, value any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	st, ok := t.(snapshotT)
+	if !ok {
+		return check(t, false, "cannot use snapshots, %T does not have Name and Cleanup methods", t)
+	}
+
+	testName := st.Name()
+	topLevelName, _, _ := strings.Cut(testName, "/")
+	path := filepath.Join(snapshotDir, topLevelName+".snap")
+	actual := formatSnapshot(value)
+
+	snapshots.mu.Lock()
+	defer snapshots.mu.Unlock()
+
+	if snapshots.counts[testName] == 0 {
+		st.Cleanup(func() {
+			snapshots.mu.Lock()
+			defer snapshots.mu.Unlock()
+			delete(snapshots.counts, testName)
+		})
+	}
+	snapshots.counts[testName]++
+	key := fmt.Sprintf("%s #%d", testName, snapshots.counts[testName])
+
+	file, err := loadSnapshotFile(path)
+	if err != nil {
+		return check(t, false, "cannot read snapshot file %s: %v", path, err)
+	}
+	if snapshots.used[path] == nil {
+		snapshots.used[path] = map[string]bool{}
+	}
+	snapshots.used[path][key] = true
+
+	expected, exists := file.entries[key]
+	switch {
+	case exists && expected == actual:
+		return true
+	case update.Enabled():
+		file.entries[key] = actual
+		delete(file.pending, key)
+		if err := file.write(); err != nil {
+			return check(t, false, "cannot update snapshot file %s: %v", path, err)
+		}
+		t.Log(fmt.Sprintf("checkmate: updated snapshot %q in %s", key, path))
+		return true
+	case !exists && inCI():
+		return check(
+			t, false,
+			"snapshot %q does not exist in %s, new snapshots are not written when CI is set",
+			key, path,
+		)
+	case !exists:
+		file.entries[key] = actual
+		if err := file.write(); err != nil {
+			return check(t, false, "cannot write snapshot file %s: %v", path, err)
+		}
+		t.Log(fmt.Sprintf("checkmate: wrote new snapshot %q to %s", key, path))
+		return true
+	}
+
+	file.pending[key] = actual
+	if err := file.writePending(); err != nil {
+		return check(t, false, "cannot write snapshot file %s.new: %v", path, err)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"snapshot %q does not match (-snapshot +actual):\n%s\n" +
+				"review the new snapshot in %s.new and run the tests with -checkmate.update to accept it",
+			key, unifiedDiff(expected, actual), path,
+		}
+	}
+
+	return check(t, false, msgAndArgs...)
+}
+
+// VerifySnapshots runs the tests and then reports snapshot entries which no
+// test matched, failing the run if there are any. The entries are never
+// removed, since a test may have been skipped rather than deleted. Nothing
+// is reported when the tests fail or only some of them were run, because of
+// -run, -skip, or -short.
+//
+// It returns the exit code to pass to os.Exit from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(check.VerifySnapshots(m))
+//	}
+func VerifySnapshots(m checkmate.M) int {
+	code := m.Run()
+	if code != 0 || testsFiltered() {
+		return code
+	}
+
+	return verifySnapshots(os.Stderr)
+}
+
+// verifySnapshots reports the obsolete entries of every snapshot file and
+// returns the resulting exit code.
+func verifySnapshots(w io.Writer) int {
+	snapshots.mu.Lock()
+	defer snapshots.mu.Unlock()
+
+	paths, err := filepath.Glob(filepath.Join(snapshotDir, "*.snap"))
+	if err != nil {
+		fmt.Fprintf(w, "checkmate: cannot look for obsolete snapshots: %v\n", err)
+		return 1
+	}
+
+	var obsolete []string
+	for _, path := range paths {
+		file, err := loadSnapshotFile(path)
+		if err != nil {
+			fmt.Fprintf(w, "checkmate: cannot read snapshot file %s: %v\n", path, err)
+			return 1
+		}
+
+		var keys []string
+		for key := range file.entries {
+			if !snapshots.used[path][key] {
+				keys = append(keys, key)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return snapshotKeyLess(keys[i], keys[j]) })
+		for _, key := range keys {
+			obsolete = append(obsolete, fmt.Sprintf("%s: %q", path, key))
+		}
+	}
+
+	if len(obsolete) == 0 {
+		return 0
+	}
+
+	fmt.Fprintln(w, "checkmate: found snapshots which no test matched:")
+	for _, entry := range obsolete {
+		fmt.Fprintf(w, "  %s\n", entry)
+	}
+	fmt.Fprintln(w, "remove them if no test needs them anymore")
+	return 1
+}
+
+// snapshotFile is the parsed contents of a .snap file.
+type snapshotFile struct {
+	path    string
+	entries map[string]string
+	// pending holds the mismatched values which were written to the .new
+	// file for review.
+	pending map[string]string
+}
+
+// loadSnapshotFile returns the cached snapshot file at path, reading it
+// the first time. A missing file has no entries. snapshots.mu must be held.
+func loadSnapshotFile(path string) (*snapshotFile, error) {
+	if file, ok := snapshots.files[path]; ok {
+		return file, nil
+	}
+
+	file := &snapshotFile{path: path, entries: map[string]string{}, pending: map[string]string{}}
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	file.entries = parseSnapshots(string(data))
+
+	snapshots.files[path] = file
+	return file, nil
+}
+
+// write saves the entries to the snapshot file and removes the .new file,
+// which is out of date once the snapshots change.
+func (f *snapshotFile) write() error {
+	if err := writeGoldenFile(f.path, encodeSnapshots(f.entries)); err != nil {
+		return err
+	}
+	if err := os.Remove(f.path + ".new"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// writePending saves the entries with the pending values applied to the
+// .new file.
+func (f *snapshotFile) writePending() error {
+	entries := make(map[string]string, len(f.entries))
+	for key, value := range f.entries {
+		entries[key] = value
+	}
+	for key, value := range f.pending {
+		entries[key] = value
+	}
+	return os.WriteFile(f.path+".new", encodeSnapshots(entries), 0o644)
+}
+
+// parseSnapshots parses the entries of a snapshot file. Each entry is a
+// "-- <key> --" line followed by the serialized value.
+func parseSnapshots(data string) map[string]string {
+	entries := map[string]string{}
+
+	var key string
+	var lines []string
+	inEntry := false
+	flush := func() {
+		if inEntry {
+			entries[key] = strings.Join(lines, "\n")
+		}
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(data, "\n"), "\n") {
+		if strings.HasPrefix(line, "-- ") && strings.HasSuffix(line, " --") && len(line) >= 6 {
+			flush()
+			key, lines, inEntry = line[3:len(line)-3], nil, true
+			continue
+		}
+		if inEntry {
+			lines = append(lines, line)
+		}
+	}
+	flush()
+
+	// Entries are separated by a blank line, which is not part of the
+	// value.
+	for key, value := range entries {
+		entries[key] = strings.TrimSuffix(value, "\n")
+	}
+	return entries
+}
+
+// encodeSnapshots formats entries as a snapshot file, ordered by test name
+// and then by call order.
+func encodeSnapshots(entries map[string]string) []byte {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return snapshotKeyLess(keys[i], keys[j]) })
+
+	var out strings.Builder
+	out.WriteString(snapshotFileHeader)
+	for _, key := range keys {
+		fmt.Fprintf(&out, "\n-- %s --\n%s\n", key, entries[key])
+	}
+	return []byte(out.String())
+}
+
+// snapshotKeyLess orders "<test name> #<n>" keys by test name and then
+// numerically by n.
+func snapshotKeyLess(a, b string) bool {
+	aName, aCount, _ := strings.Cut(a, " #")
+	bName, bCount, _ := strings.Cut(b, " #")
+	if aName != bName {
+		return aName < bName
+	}
+	aNum, _ := strconv.Atoi(aCount)
+	bNum, _ := strconv.Atoi(bCount)
+	return aNum < bNum
+}
+
+// inCI reports whether the tests run in continuous integration, where
+// snapshots should already exist.
+func inCI() bool {
+	ci, _ := strconv.ParseBool(os.Getenv("CI"))
+	return ci
+}
+
+// formatSnapshot serializes value deterministically for a snapshot.
+func formatSnapshot(value any) string {
+	var out strings.Builder
+	printer := &snapshotPrinter{out: &out, visiting: map[snapshotVisit]bool{}}
+	printer.print(reflect.ValueOf(value), 0)
+	return out.String()
+}
+
+// snapshotPrinter writes Go values in a Go-like syntax, one field or
+// element per line.
+type snapshotPrinter struct {
+	out *strings.Builder
+	// visiting holds the pointers, maps, and slices being printed, to
+	// detect cycles.
+	visiting map[snapshotVisit]bool
+}
+
+// snapshotVisit identifies a reference by its address and type, since a
+// struct and its first field share an address.
+type snapshotVisit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func (p *snapshotPrinter) print(val reflect.Value, depth int) {
+	if !val.IsValid() {
+		p.out.WriteString("nil")
+		return
+	}
+
+	typ := val.Type()
+	named := typ.Name() != "" && typ.PkgPath() != ""
+
+	switch val.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		if named {
+			fmt.Fprintf(p.out, "%s(%v)", typ, val)
+		} else {
+			fmt.Fprintf(p.out, "%v", val)
+		}
+	case reflect.String:
+		if named {
+			fmt.Fprintf(p.out, "%s(%q)", typ, val.String())
+		} else {
+			fmt.Fprintf(p.out, "%q", val.String())
+		}
+	case reflect.Interface:
+		if val.IsNil() {
+			p.out.WriteString("nil")
+			return
+		}
+		p.print(val.Elem(), depth)
+	case reflect.Pointer:
+		if val.IsNil() {
+			fmt.Fprintf(p.out, "(%s)(nil)", typ)
+			return
+		}
+		// Most errors, such as *errors.errorString, implement error on the
+		// pointer, so the pointer is the value which describes itself.
+		if description, ok := describeStruct(val, val.Elem().Type()); ok {
+			fmt.Fprintf(p.out, "%s(%q)", typ, description)
+			return
+		}
+		if p.enter(val) {
+			fmt.Fprintf(p.out, "<cycle %s>", typ)
+			return
+		}
+		defer p.leave(val)
+		p.out.WriteString("&")
+		p.print(val.Elem(), depth)
+	case reflect.Struct:
+		p.printStruct(val, depth)
+	case reflect.Map:
+		p.printMap(val, depth)
+	case reflect.Slice:
+		if val.IsNil() {
+			fmt.Fprintf(p.out, "%s(nil)", typ)
+			return
+		}
+		if typ.Elem().Kind() == reflect.Uint8 {
+			fmt.Fprintf(p.out, "%s(%q)", typ, val.Bytes())
+			return
+		}
+		if p.enter(val) {
+			fmt.Fprintf(p.out, "<cycle %s>", typ)
+			return
+		}
+		defer p.leave(val)
+		p.printElements(val, depth)
+	case reflect.Array:
+		p.printElements(val, depth)
+	default:
+		// Channels, functions, and unsafe pointers only differ by address,
+		// which is not stable between runs.
+		if val.IsNil() {
+			fmt.Fprintf(p.out, "(%s)(nil)", typ)
+		} else {
+			fmt.Fprintf(p.out, "(%s)(non-nil)", typ)
+		}
+	}
+}
+
+// printStruct prints each field of a struct. Structs which have no exported
+// fields but describe themselves, such as time.Time, are printed with their
+// String or Error method instead.
+func (p *snapshotPrinter) printStruct(val reflect.Value, depth int) {
+	typ := val.Type()
+
+	description, ok := describeStruct(val, typ)
+	if !ok && val.CanAddr() {
+		description, ok = describeStruct(val.Addr(), typ)
+	}
+	if ok {
+		fmt.Fprintf(p.out, "%s(%q)", typ, description)
+		return
+	}
+
+	if typ.NumField() == 0 {
+		fmt.Fprintf(p.out, "%s{}", typ)
+		return
+	}
+
+	fmt.Fprintf(p.out, "%s{\n", typ)
+	for i := 0; i < typ.NumField(); i++ {
+		p.indent(depth + 1)
+		fmt.Fprintf(p.out, "%s: ", typ.Field(i).Name)
+		p.print(val.Field(i), depth+1)
+		p.out.WriteString(",\n")
+	}
+	p.indent(depth)
+	p.out.WriteString("}")
+}
+
+// describeStruct returns the result of val's Error or String method, where
+// val is either a struct of type typ or a pointer to one, provided that typ
+// has no exported fields to print instead.
+func describeStruct(val reflect.Value, typ reflect.Type) (string, bool) {
+	if typ.Kind() != reflect.Struct || !val.CanInterface() {
+		return "", false
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).IsExported() {
+			return "", false
+		}
+	}
+
+	switch v := val.Interface().(type) {
+	case error:
+		return v.Error(), true
+	case fmt.Stringer:
+		return v.String(), true
+	}
+	return "", false
+}
+
+// printMap prints the entries of a map ordered by their printed keys.
+func (p *snapshotPrinter) printMap(val reflect.Value, depth int) {
+	typ := val.Type()
+	if val.IsNil() {
+		fmt.Fprintf(p.out, "%s(nil)", typ)
+		return
+	}
+	if val.Len() == 0 {
+		fmt.Fprintf(p.out, "%s{}", typ)
+		return
+	}
+	if p.enter(val) {
+		fmt.Fprintf(p.out, "<cycle %s>", typ)
+		return
+	}
+	defer p.leave(val)
+
+	type entry struct {
+		key   string
+		value reflect.Value
+	}
+	entries := make([]entry, 0, val.Len())
+	iter := val.MapRange()
+	for iter.Next() {
+		entries = append(entries, entry{p.sub(iter.Key(), depth+1), iter.Value()})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	fmt.Fprintf(p.out, "%s{\n", typ)
+	for _, e := range entries {
+		p.indent(depth + 1)
+		fmt.Fprintf(p.out, "%s: ", e.key)
+		p.print(e.value, depth+1)
+		p.out.WriteString(",\n")
+	}
+	p.indent(depth)
+	p.out.WriteString("}")
+}
+
+// printElements prints the elements of a slice or an array.
+func (p *snapshotPrinter) printElements(val reflect.Value, depth int) {
+	if val.Len() == 0 {
+		fmt.Fprintf(p.out, "%s{}", val.Type())
+		return
+	}
+
+	fmt.Fprintf(p.out, "%s{\n", val.Type())
+	for i := 0; i < val.Len(); i++ {
+		p.indent(depth + 1)
+		p.print(val.Index(i), depth+1)
+		p.out.WriteString(",\n")
+	}
+	p.indent(depth)
+	p.out.WriteString("}")
+}
+
+// sub prints val on its own and returns the result.
+func (p *snapshotPrinter) sub(val reflect.Value, depth int) string {
+	out := p.out
+	defer func() { p.out = out }()
+
+	var sub strings.Builder
+	p.out = &sub
+	p.print(val, depth)
+	return sub.String()
+}
+
+// enter marks the value at val's address as being printed and reports
+// whether it already was, meaning val is part of a cycle.
+func (p *snapshotPrinter) enter(val reflect.Value) bool {
+	visit := snapshotVisit{val.Pointer(), val.Type()}
+	if p.visiting[visit] {
+		return true
+	}
+	p.visiting[visit] = true
+	return false
+}
+
+func (p *snapshotPrinter) leave(val reflect.Value) {
+	delete(p.visiting, snapshotVisit{val.Pointer(), val.Type()})
+}
+
+func (p *snapshotPrinter) indent(depth int) {
+	p.out.WriteString(strings.Repeat("  ", depth))
+}
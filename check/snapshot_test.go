@@ -0,0 +1,246 @@
+package check
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+	"github.com/eugenetriguba/checkmate/internal/update"
+)
+
+// useTestSnapshotDir points the snapshot functions at a temporary directory
+// and forgets any snapshot files read so far.
+func useTestSnapshotDir(t *testing.T) string {
+	t.Helper()
+	t.Setenv("CI", "")
+
+	dir := t.TempDir()
+	previousDir := snapshotDir
+	snapshotDir = dir
+	t.Cleanup(func() { snapshotDir = previousDir })
+
+	snapshots.mu.Lock()
+	defer snapshots.mu.Unlock()
+	snapshots.files = map[string]*snapshotFile{}
+	snapshots.used = map[string]map[string]bool{}
+	snapshots.counts = map[string]int{}
+
+	return dir
+}
+
+// snapshotCounter describes itself with a pointer method.
+type snapshotCounter struct {
+	n int
+}
+
+func (c *snapshotCounter) String() string {
+	return fmt.Sprintf("%d calls", c.n)
+}
+
+type snapshotUser struct {
+	Name    string
+	Tags    []string
+	Friends map[string]*snapshotUser
+	Created time.Time
+	secret  string
+}
+
+type snapshotNode struct {
+	Value int
+	Next  *snapshotNode
+}
+
+type snapshotLevel int
+
+func TestFormatSnapshot(t *testing.T) {
+	node := &snapshotNode{Value: 1}
+	node.Next = node
+
+	testCases := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"Nil", nil, "nil"},
+		{"String", "a\nb", `"a\nb"`},
+		{"Named", snapshotLevel(3), "check.snapshotLevel(3)"},
+		{"Bytes", []byte("hi"), `[]uint8("hi")`},
+		{"Nil slice", []int(nil), "[]int(nil)"},
+		{"Empty map", map[string]int{}, "map[string]int{}"},
+		{"Map keys are sorted", map[string]int{"b": 2, "a": 1, "c": 3},
+			"map[string]int{\n  \"a\": 1,\n  \"b\": 2,\n  \"c\": 3,\n}"},
+		{"Channel", make(chan int), "(chan int)(non-nil)"},
+		{"Error", errors.New("boom"), `*errors.errorString("boom")`},
+		{"Pointer method on an addressable value", []snapshotCounter{{n: 2}},
+			"[]check.snapshotCounter{\n  check.snapshotCounter(\"2 calls\"),\n}"},
+		{"Cycle", node, "&check.snapshotNode{\n  Value: 1,\n  Next: <cycle *check.snapshotNode>,\n}"},
+		{"Struct", &snapshotUser{
+			Name:    "gopher",
+			Tags:    []string{"go"},
+			Friends: map[string]*snapshotUser{"nil": nil},
+			Created: time.Date(2024, 2, 19, 0, 0, 0, 0, time.UTC),
+			secret:  "hidden",
+		}, `&check.snapshotUser{
+  Name: "gopher",
+  Tags: []string{
+    "go",
+  },
+  Friends: map[string]*check.snapshotUser{
+    "nil": (*check.snapshotUser)(nil),
+  },
+  Created: time.Time("2024-02-19 00:00:00 +0000 UTC"),
+  secret: "hidden",
+}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := formatSnapshot(tc.value); got != tc.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMatchSnapshot(t *testing.T) {
+	dir := useTestSnapshotDir(t)
+	path := filepath.Join(dir, "TestUsers.snap")
+
+	run := func(values ...any) *cmtest.MockTB {
+		mockT := &cmtest.MockTB{TestName: "TestUsers/admin"}
+		for _, value := range values {
+			MatchSnapshot(mockT, value)
+		}
+		mockT.RunCleanups()
+		return mockT
+	}
+
+	mockT := run(map[string]int{"b": 2, "a": 1}, "second")
+	if mockT.FailCalled {
+		t.Fatalf("expected new snapshots to pass, logs: %v", mockT.Logs)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := snapshotFileHeader +
+		"\n-- TestUsers/admin #1 --\nmap[string]int{\n  \"a\": 1,\n  \"b\": 2,\n}\n" +
+		"\n-- TestUsers/admin #2 --\n\"second\"\n"
+	if string(data) != want {
+		t.Fatalf("expected snapshot file:\n%s\ngot:\n%s", want, data)
+	}
+
+	// A new run reads the file back.
+	snapshots.files = map[string]*snapshotFile{}
+	if mockT := run(map[string]int{"a": 1, "b": 2}, "second"); mockT.FailCalled {
+		t.Fatalf("expected matching snapshots to pass, logs: %v", mockT.Logs)
+	}
+
+	mockT = run(map[string]int{"a": 1, "b": 3}, "second")
+	if !mockT.FailCalled || len(mockT.Logs) != 1 {
+		t.Fatalf("expected a mismatch to fail with one log, got %v", mockT.Logs)
+	}
+	wantLog := `snapshot "TestUsers/admin #1" does not match (-snapshot +actual):
+@@ -1,4 +1,4 @@
+ map[string]int{
+   "a": 1,
+-  "b": 2,
++  "b": 3,
+ }
+review the new snapshot in ` + path + `.new and run the tests with -checkmate.update to accept it`
+	if mockT.Logs[0] != wantLog {
+		t.Errorf("expected log:\n%s\ngot:\n%s", wantLog, mockT.Logs[0])
+	}
+	pending, err := os.ReadFile(path + ".new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(pending), `"b": 3`) || !strings.Contains(string(pending), `"second"`) {
+		t.Errorf("expected the .new file to hold every snapshot with the new value, got:\n%s", pending)
+	}
+
+	t.Setenv(update.EnvVar, "1")
+	if mockT := run(map[string]int{"a": 1, "b": 3}); mockT.FailCalled {
+		t.Fatalf("expected update mode to pass, logs: %v", mockT.Logs)
+	}
+	if _, err := os.Stat(path + ".new"); !os.IsNotExist(err) {
+		t.Errorf("expected the .new file to be removed after updating, got %v", err)
+	}
+}
+
+func TestMatchSnapshotInCI(t *testing.T) {
+	useTestSnapshotDir(t)
+	t.Setenv("CI", "true")
+	mockT := &cmtest.MockTB{TestName: "TestCI"}
+
+	if MatchSnapshot(mockT, 1) {
+		t.Fatal("expected a missing snapshot to fail in CI")
+	}
+	if len(mockT.Logs) != 1 || !strings.HasPrefix(mockT.Logs[0], `snapshot "TestCI #1" does not exist in`) {
+		t.Errorf("unexpected logs: %v", mockT.Logs)
+	}
+}
+
+func TestMatchSnapshotWithoutName(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	if MatchSnapshot(mockT, 1) {
+		t.Fatal("expected MatchSnapshot to fail")
+	}
+	if len(mockT.Logs) != 1 || mockT.Logs[0] != "cannot use snapshots, *cmtest.MockT does not have Name and Cleanup methods" {
+		t.Errorf("unexpected logs: %v", mockT.Logs)
+	}
+}
+
+func TestVerifySnapshots(t *testing.T) {
+	dir := useTestSnapshotDir(t)
+	path := filepath.Join(dir, "TestKept.snap")
+	contents := snapshotFileHeader + "\n-- TestKept #1 --\n1\n\n-- TestKept/removed #1 --\n2\n"
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "TestGone.snap"), []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	mockT := &cmtest.MockTB{TestName: "TestKept"}
+	if !MatchSnapshot(mockT, 1) {
+		t.Fatalf("expected the snapshot to match, logs: %v", mockT.Logs)
+	}
+
+	var out bytes.Buffer
+	if code := verifySnapshots(&out); code != 1 {
+		t.Errorf("expected exit code 1, got %d", code)
+	}
+	gone := filepath.Join(dir, "TestGone.snap")
+	want := "checkmate: found snapshots which no test matched:\n" +
+		"  " + gone + ": \"TestKept #1\"\n" +
+		"  " + gone + ": \"TestKept/removed #1\"\n" +
+		"  " + path + ": \"TestKept/removed #1\"\n" +
+		"remove them if no test needs them anymore\n"
+	if out.String() != want {
+		t.Errorf("expected report:\n%s\ngot:\n%s", want, out.String())
+	}
+
+	t.Setenv(update.EnvVar, "1")
+	out.Reset()
+	if code := verifySnapshots(&out); code != 1 {
+		t.Errorf("expected exit code 1 in update mode, got %d", code)
+	}
+	if _, err := os.Stat(gone); err != nil {
+		t.Errorf("expected %s to be kept in update mode, got %v", gone, err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != contents {
+		t.Errorf("expected the snapshot file to be unchanged, got:\n%s", data)
+	}
+}
@@ -0,0 +1,286 @@
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// diffContextLines is how many unchanged lines surround each hunk of a
+// unified diff.
+const diffContextLines = 3
+
+// HasPrefix checks whether s begins with prefix.
+func HasPrefix(t checkmate.TestingT, s, prefix string, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %q to have prefix %q", s, prefix}
+	}
+
+	return check(t, strings.HasPrefix(s, prefix), msgAndArgs...)
+}
+
+// HasSuffix checks whether s ends with suffix.
+func HasSuffix(t checkmate.TestingT, s, suffix string, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %q to have suffix %q", s, suffix}
+	}
+
+	return check(t, strings.HasSuffix(s, suffix), msgAndArgs...)
+}
+
+// ContainsString checks whether s contains substr.
+func ContainsString(t checkmate.TestingT, s, substr string, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %q to contain %q", s, substr}
+	}
+
+	return check(t, strings.Contains(s, substr), msgAndArgs...)
+}
+
+// MatchesRegexp checks whether s matches the regular expression pattern.
+func MatchesRegexp(t checkmate.TestingT, s, pattern string, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return check(t, false, "invalid pattern %q: %v", pattern, err)
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %q to match %q", s, pattern}
+	}
+
+	return check(t, re.MatchString(s), msgAndArgs...)
+}
+
+// EqualFold checks whether actual and expected are equal under simple
+// Unicode case folding, e.g. "Go" and "GO".
+func EqualFold(t checkmate.TestingT, actual, expected string, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected %q to equal %q ignoring case", actual, expected}
+	}
+
+	return check(t, strings.EqualFold(actual, expected), msgAndArgs...)
+}
+
+// describeStringMismatch explains how two unequal strings differ. Multi-line
+// strings get a line-based unified diff, while single-line strings are shown
+// quoted with a caret under the first differing rune. Invisible characters
+// are escaped in both forms.
+func describeStringMismatch(actual, expected string) string {
+	if strings.Contains(actual, "\n") || strings.Contains(expected, "\n") {
+		return "strings differ (-expected +actual):\n" + unifiedDiff(expected, actual)
+	}
+
+	prefixLen := commonPrefixLen(actual, expected)
+	caretColumn := len("  expected: ") + utf8.RuneCountInString(strconv.Quote(expected[:prefixLen])) - 1
+	return fmt.Sprintf(
+		"strings differ:\n  actual:   %s\n  expected: %s\n%s^ first difference at rune %d",
+		strconv.Quote(actual), strconv.Quote(expected),
+		strings.Repeat(" ", caretColumn), utf8.RuneCountInString(expected[:prefixLen]),
+	)
+}
+
+// commonPrefixLen returns the length in bytes of the longest common prefix
+// of a and b that ends on a rune boundary.
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) {
+		aRune, aSize := utf8.DecodeRuneInString(a[n:])
+		bRune, bSize := utf8.DecodeRuneInString(b[n:])
+		if aRune != bRune || aSize != bSize {
+			break
+		}
+		n += aSize
+	}
+	return n
+}
+
+// diffOp is a single line of a line-based diff.
+type diffOp struct {
+	kind byte // ' ', '-', or '+'
+	line string
+	// expectedLine and actualLine are the 0-based line numbers the op
+	// occurs at in each input.
+	expectedLine, actualLine int
+}
+
+// unifiedDiff returns a unified diff of the lines of expected and actual.
+func unifiedDiff(expected, actual string) string {
+	ops := diffLines(strings.Split(expected, "\n"), strings.Split(actual, "\n"))
+
+	var out strings.Builder
+	for start := 0; start < len(ops); {
+		if ops[start].kind == ' ' {
+			start++
+			continue
+		}
+
+		// Extend the hunk until a run of unchanged lines is long enough to
+		// separate it from the next change.
+		hunkStart := max(0, start-diffContextLines)
+		end := start
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].kind == ' ' {
+				run++
+			}
+			if run == len(ops) || run-end > 2*diffContextLines {
+				end = min(run, end+diffContextLines)
+				break
+			}
+			end = run
+		}
+
+		writeHunk(&out, ops[hunkStart:end])
+		start = end
+	}
+
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// writeHunk writes a hunk header followed by its lines.
+func writeHunk(out *strings.Builder, hunk []diffOp) {
+	var expectedCount, actualCount int
+	for _, op := range hunk {
+		if op.kind != '+' {
+			expectedCount++
+		}
+		if op.kind != '-' {
+			actualCount++
+		}
+	}
+	fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n",
+		hunk[0].expectedLine+1, expectedCount, hunk[0].actualLine+1, actualCount)
+	for _, op := range hunk {
+		fmt.Fprintf(out, "%c%s\n", op.kind, visibleLine(op.line))
+	}
+}
+
+// maxDiffCells bounds the size of the table diffLines builds, so that
+// diffing large inputs does not allocate gigabytes.
+const maxDiffCells = 1 << 22
+
+// diffLines computes a minimal line diff of expected and actual using the
+// longest common subsequence of lines. Common leading and trailing lines
+// are trimmed first to keep the quadratic table small. When the remaining
+// lines would still need more than maxDiffCells entries, they are reported
+// as all removed and all added instead.
+func diffLines(expected, actual []string) []diffOp {
+	prefix := 0
+	for prefix < len(expected) && prefix < len(actual) && expected[prefix] == actual[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(expected)-prefix && suffix < len(actual)-prefix &&
+		expected[len(expected)-1-suffix] == actual[len(actual)-1-suffix] {
+		suffix++
+	}
+
+	midExpected := expected[prefix : len(expected)-suffix]
+	midActual := actual[prefix : len(actual)-suffix]
+
+	ops := make([]diffOp, 0, len(expected)+len(actual))
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, diffOp{' ', expected[i], i, i})
+	}
+
+	if (len(midExpected)+1)*(len(midActual)+1) > maxDiffCells {
+		for i, line := range midExpected {
+			ops = append(ops, diffOp{'-', line, prefix + i, prefix})
+		}
+		for j, line := range midActual {
+			ops = append(ops, diffOp{'+', line, prefix + len(midExpected), prefix + j})
+		}
+	} else {
+		ops = appendLCSDiff(ops, midExpected, midActual, prefix)
+	}
+
+	for k := 0; k < suffix; k++ {
+		ops = append(ops, diffOp{
+			' ', expected[len(expected)-suffix+k],
+			len(expected) - suffix + k, len(actual) - suffix + k,
+		})
+	}
+
+	return ops
+}
+
+// appendLCSDiff appends a minimal diff of expected and actual, whose first
+// lines are at offset in the whole inputs, to ops.
+func appendLCSDiff(ops []diffOp, expected, actual []string, offset int) []diffOp {
+	// lcs[i][j] is the length of the longest common subsequence of
+	// expected[i:] and actual[j:].
+	lcs := make([][]int, len(expected)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(actual)+1)
+	}
+	for i := len(expected) - 1; i >= 0; i-- {
+		for j := len(actual) - 1; j >= 0; j-- {
+			if expected[i] == actual[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(expected) || j < len(actual) {
+		switch {
+		case i < len(expected) && j < len(actual) && expected[i] == actual[j]:
+			ops = append(ops, diffOp{' ', expected[i], offset + i, offset + j})
+			i++
+			j++
+		case j == len(actual) || (i < len(expected) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', expected[i], offset + i, offset + j})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', actual[j], offset + i, offset + j})
+			j++
+		}
+	}
+	return ops
+}
+
+// visibleLine quotes a diff line if it contains characters that would
+// otherwise be invisible, such as trailing whitespace, carriage returns, or
+// non-printable runes.
+func visibleLine(line string) string {
+	if strings.TrimRightFunc(line, unicode.IsSpace) != line {
+		return strconv.Quote(line)
+	}
+	for _, r := range line {
+		if r != '\t' && !strconv.IsPrint(r) {
+			return strconv.Quote(line)
+		}
+	}
+	return line
+}
@@ -0,0 +1,122 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestStringChecks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"HasPrefix", func(t checkmate.TestingT) bool { return HasPrefix(t, "checkmate", "mate") }, false,
+			`expected "checkmate" to have prefix "mate"`},
+		{"HasSuffix", func(t checkmate.TestingT) bool { return HasSuffix(t, "checkmate", "check") }, false,
+			`expected "checkmate" to have suffix "check"`},
+		{"ContainsString", func(t checkmate.TestingT) bool { return ContainsString(t, "checkmate", "xyz") }, false,
+			`expected "checkmate" to contain "xyz"`},
+		{"MatchesRegexp", func(t checkmate.TestingT) bool { return MatchesRegexp(t, "v1.2", `^v\d+\.\d+\.\d+$`) },
+			false, `expected "v1.2" to match "^v\\d+\\.\\d+\\.\\d+$"`},
+		{"MatchesRegexp invalid pattern", func(t checkmate.TestingT) bool { return MatchesRegexp(t, "a", "(") },
+			false, "invalid pattern \"(\": error parsing regexp: missing closing ): `(`"},
+		{"EqualFold unicode", func(t checkmate.TestingT) bool { return EqualFold(t, "straße", "STRAßE") }, true, ""},
+		{"EqualFold", func(t checkmate.TestingT) bool { return EqualFold(t, "Go", "Rust") }, false,
+			`expected "Go" to equal "Rust" ignoring case`},
+		{"Equal escapes invisible characters", func(t checkmate.TestingT) bool { return Equal(t, "a\tb", "a b") },
+			false,
+			"strings differ:\n" +
+				"  actual:   \"a\\tb\"\n" +
+				"  expected: \"a b\"\n" +
+				"              ^ first difference at rune 1"},
+		{"Equal caret after multi-byte runes", func(t checkmate.TestingT) bool { return Equal(t, "héllo", "héllø") },
+			false,
+			"strings differ:\n" +
+				"  actual:   \"héllo\"\n" +
+				"  expected: \"héllø\"\n" +
+				"                 ^ first difference at rune 4"},
+		{"Equal multi-line",
+			func(t checkmate.TestingT) bool {
+				return Equal(t, "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight", "one\ntwo\nthree\nfour\n5\nsix\nseven\neight")
+			}, false,
+			"strings differ (-expected +actual):\n" +
+				"@@ -2,7 +2,7 @@\n" +
+				" two\n" +
+				" three\n" +
+				" four\n" +
+				"-5\n" +
+				"+five\n" +
+				" six\n" +
+				" seven\n" +
+				" eight"},
+		{"Equal multi-line trailing whitespace",
+			func(t checkmate.TestingT) bool { return Equal(t, "a \nb", "a\nb") }, false,
+			"strings differ (-expected +actual):\n" +
+				"@@ -1,2 +1,2 @@\n" +
+				"-a\n" +
+				"+\"a \"\n" +
+				" b"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiffSeparatesDistantHunks(t *testing.T) {
+	lines := make([]string, 20)
+	for i := range lines {
+		lines[i] = strings.Repeat("x", i+1)
+	}
+	expected := strings.Join(lines, "\n")
+	lines[1], lines[18] = "changed", "changed"
+	actual := strings.Join(lines, "\n")
+
+	diff := unifiedDiff(expected, actual)
+
+	if got := strings.Count(diff, "@@ -"); got != 2 {
+		t.Fatalf("expected 2 hunks, got %d:\n%s", got, diff)
+	}
+	if !strings.HasPrefix(diff, "@@ -1,5 +1,5 @@\n") || !strings.Contains(diff, "\n@@ -16,5 +16,5 @@\n") {
+		t.Errorf("unexpected hunk headers:\n%s", diff)
+	}
+}
+
+func TestUnifiedDiffOfLargeInputs(t *testing.T) {
+	expectedLines := make([]string, 20000)
+	actualLines := make([]string, 20000)
+	for i := range expectedLines {
+		expectedLines[i] = fmt.Sprintf("expected %d", i)
+		actualLines[i] = fmt.Sprintf("actual %d", i)
+	}
+	expectedLines[0], actualLines[0] = "same", "same"
+
+	diff := unifiedDiff(strings.Join(expectedLines, "\n"), strings.Join(actualLines, "\n"))
+
+	if !strings.HasPrefix(diff, "@@ -1,20000 +1,20000 @@\n same\n-expected 1\n-expected 2\n") {
+		t.Errorf("expected every differing line to be removed and added, got:\n%s", diff[:min(len(diff), 200)])
+	}
+	if strings.Count(diff, "\n+actual ") != 19999 {
+		t.Errorf("expected 19999 added lines, got %d", strings.Count(diff, "\n+actual "))
+	}
+}
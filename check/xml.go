@@ -0,0 +1,280 @@
+package check
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/eugenetriguba/checkmate"
+)
+
+// XMLEq checks whether actual and expected are equivalent XML documents.
+// Elements and attributes are compared by namespace URI and local name, so
+// the prefixes bound to a namespace do not matter. Attribute order is
+// ignored, text is compared with its whitespace collapsed, and comments and
+// processing instructions are skipped. Each document may be a []byte, a
+// string, or an io.Reader.
+//
+// Differences are reported by path, e.g. `/catalog/book[2]/@id: "b" != "c"`,
+// with the actual value first. The IgnorePaths, AllowExtraFields, and
+// ShowCanonical options apply, where AllowExtraFields ignores extra
+// attributes.
+func XMLEq(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	opts, msgAndArgs := splitOptions[DocumentOption](msgAndArgs)
+
+	actualDoc, err := readXMLDocument(actual)
+	if err != nil {
+		return check(t, false, "cannot decode actual XML: %v", err)
+	}
+	expectedDoc, err := readXMLDocument(expected)
+	if err != nil {
+		return check(t, false, "cannot decode expected XML: %v", err)
+	}
+
+	config := newDocumentConfig(opts...)
+	var differences []documentDifference
+	config.compareXML([]any{expectedDoc.name.Local}, expectedDoc, actualDoc, &differences)
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"XML documents differ (actual != expected):\n%s%s",
+			formatDifferences(differences, formatXMLPath),
+			config.canonical(canonicalXML(actualDoc), canonicalXML(expectedDoc)),
+		}
+	}
+
+	return check(t, len(differences) == 0, msgAndArgs...)
+}
+
+// xmlElement is an element of a decoded XML document.
+type xmlElement struct {
+	name  xml.Name
+	attrs map[xml.Name]string
+	// text is the element's own character data with whitespace collapsed.
+	text     string
+	children []*xmlElement
+}
+
+// readXMLDocument reads and decodes the root element of an XML document.
+func readXMLDocument(doc any) (*xmlElement, error) {
+	data, err := readDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var root *xmlElement
+	var stack []*xmlElement
+	var texts []*strings.Builder
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			element := &xmlElement{name: token.Name, attrs: map[xml.Name]string{}}
+			for _, attr := range token.Attr {
+				// Namespace declarations only bind prefixes, which are
+				// already resolved in the names.
+				if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+					continue
+				}
+				element.attrs[attr.Name] = attr.Value
+			}
+
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			} else if root != nil {
+				return nil, errors.New("more than one root element")
+			} else {
+				root = element
+			}
+			stack = append(stack, element)
+			texts = append(texts, &strings.Builder{})
+		case xml.EndElement:
+			element := stack[len(stack)-1]
+			element.text = strings.Join(strings.Fields(texts[len(texts)-1].String()), " ")
+			stack, texts = stack[:len(stack)-1], texts[:len(texts)-1]
+		case xml.CharData:
+			if len(texts) > 0 {
+				texts[len(texts)-1].Write(token)
+			} else if len(bytes.TrimSpace(token)) > 0 {
+				return nil, errors.New("text outside of the root element")
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errors.New("no root element")
+	}
+	return root, nil
+}
+
+// compareXML records the differences between two elements at path.
+func (c *documentConfig) compareXML(path []any, expected, actual *xmlElement, differences *[]documentDifference) {
+	if c.ignored(path) {
+		return
+	}
+
+	addDifference := func(path []any, format string, args ...any) {
+		if !c.ignored(path) {
+			*differences = append(*differences, documentDifference{path, fmt.Sprintf(format, args...)})
+		}
+	}
+
+	if expected.name != actual.name {
+		addDifference(path, "element %s != %s", formatXMLName(actual.name), formatXMLName(expected.name))
+		return
+	}
+
+	names := make([]xml.Name, 0, len(expected.attrs)+len(actual.attrs))
+	for name := range expected.attrs {
+		names = append(names, name)
+	}
+	for name := range actual.attrs {
+		if _, ok := expected.attrs[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool { return formatXMLName(names[i]) < formatXMLName(names[j]) })
+
+	for _, name := range names {
+		attrPath := appendPath(path, "@"+formatXMLName(name))
+		expectedValue, inExpected := expected.attrs[name]
+		actualValue, inActual := actual.attrs[name]
+		switch {
+		case !inActual:
+			addDifference(attrPath, "missing, expected %q", expectedValue)
+		case !inExpected:
+			if !c.allowExtraFields {
+				addDifference(attrPath, "unexpected %q", actualValue)
+			}
+		case expectedValue != actualValue:
+			addDifference(attrPath, "%q != %q", actualValue, expectedValue)
+		}
+	}
+
+	if expected.text != actual.text {
+		addDifference(appendPath(path, "text()"), "%q != %q", actual.text, expected.text)
+	}
+
+	for i := 0; i < max(len(expected.children), len(actual.children)); i++ {
+		switch {
+		case i >= len(actual.children):
+			child := expected.children[i]
+			addDifference(appendPath(path, xmlChildSegment(expected.children, i)),
+				"missing, expected element %s", formatXMLName(child.name))
+		case i >= len(expected.children):
+			child := actual.children[i]
+			addDifference(appendPath(path, xmlChildSegment(actual.children, i)),
+				"unexpected element %s", formatXMLName(child.name))
+		default:
+			c.compareXML(
+				appendPath(path, xmlChildSegment(expected.children, i)),
+				expected.children[i], actual.children[i], differences,
+			)
+		}
+	}
+}
+
+// xmlChildSegment names the child at index i of siblings for a path. The
+// local name is followed by its 1-based position among the siblings of the
+// same name when there is more than one of them, as in XPath.
+func xmlChildSegment(siblings []*xmlElement, i int) string {
+	name := siblings[i].name
+	position, count := 0, 0
+	for j, sibling := range siblings {
+		if sibling.name == name {
+			count++
+			if j <= i {
+				position++
+			}
+		}
+	}
+
+	if count == 1 {
+		return name.Local
+	}
+	return fmt.Sprintf("%s[%d]", name.Local, position)
+}
+
+// formatXMLPath formats path segments as an XPath-like location.
+func formatXMLPath(path []any) string {
+	var out strings.Builder
+	for _, segment := range path {
+		fmt.Fprintf(&out, "/%v", segment)
+	}
+	return out.String()
+}
+
+// formatXMLName formats a name as its local name, preceded by its namespace
+// URI in braces when it has one.
+func formatXMLName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return fmt.Sprintf("{%s}%s", name.Space, name.Local)
+}
+
+// canonicalXML formats an element with sorted attributes, collapsed text,
+// and one child element per line. Namespaced attributes are written with
+// their namespace URI in braces.
+func canonicalXML(element *xmlElement) string {
+	var out strings.Builder
+	writeCanonicalXML(&out, element, "", 0)
+	return out.String()
+}
+
+func writeCanonicalXML(out *strings.Builder, element *xmlElement, parentSpace string, depth int) {
+	out.WriteString(strings.Repeat("  ", depth))
+	fmt.Fprintf(out, "<%s", element.name.Local)
+	if element.name.Space != parentSpace {
+		fmt.Fprintf(out, ` xmlns="%s"`, escapeXML(element.name.Space))
+	}
+
+	names := make([]xml.Name, 0, len(element.attrs))
+	for name := range element.attrs {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return formatXMLName(names[i]) < formatXMLName(names[j]) })
+	for _, name := range names {
+		fmt.Fprintf(out, ` %s="%s"`, formatXMLName(name), escapeXML(element.attrs[name]))
+	}
+
+	switch {
+	case len(element.children) == 0 && element.text == "":
+		out.WriteString("/>\n")
+	case len(element.children) == 0:
+		fmt.Fprintf(out, ">%s</%s>\n", escapeXML(element.text), element.name.Local)
+	default:
+		out.WriteString(">\n")
+		if element.text != "" {
+			fmt.Fprintf(out, "%s%s\n", strings.Repeat("  ", depth+1), escapeXML(element.text))
+		}
+		for _, child := range element.children {
+			writeCanonicalXML(out, child, element.name.Space, depth+1)
+		}
+		fmt.Fprintf(out, "%s</%s>\n", strings.Repeat("  ", depth), element.name.Local)
+	}
+}
+
+// escapeXML escapes text for use in character data or an attribute value.
+func escapeXML(text string) string {
+	var out strings.Builder
+	_ = xml.EscapeText(&out, []byte(text))
+	return out.String()
+}
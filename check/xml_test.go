@@ -0,0 +1,90 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestXMLEq(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"Attribute order and whitespace", func(t checkmate.TestingT) bool {
+			return XMLEq(t,
+				`<?xml version="1.0"?><catalog><book id="1" lang="en">  Go
+				Programming </book><!-- note --></catalog>`,
+				`<catalog>
+					<book lang="en" id="1">Go Programming</book>
+				</catalog>`,
+			)
+		}, true, ""},
+		{"Namespace prefixes", func(t checkmate.TestingT) bool {
+			return XMLEq(t,
+				`<a:feed xmlns:a="urn:feed" xmlns:x="urn:ext"><a:entry x:rank="1"/></a:feed>`,
+				`<feed xmlns="urn:feed"><entry xmlns:y="urn:ext" y:rank="1"></entry></feed>`,
+			)
+		}, true, ""},
+		{"Namespace differs", func(t checkmate.TestingT) bool {
+			return XMLEq(t, `<feed xmlns="urn:a"/>`, `<feed xmlns="urn:b"/>`)
+		}, false, "XML documents differ (actual != expected):\n  /feed: element {urn:a}feed != {urn:b}feed"},
+		{"Differences", func(t checkmate.TestingT) bool {
+			return XMLEq(t,
+				`<catalog><book id="1"/><book id="b" extra="x"><title>Go</title></book></catalog>`,
+				`<catalog><book id="1"/><book id="c"><title>Rust</title><year/></book></catalog>`,
+			)
+		}, false, "XML documents differ (actual != expected):\n" +
+			"  /catalog/book[2]/@extra: unexpected \"x\"\n" +
+			"  /catalog/book[2]/@id: \"b\" != \"c\"\n" +
+			"  /catalog/book[2]/title/text(): \"Go\" != \"Rust\"\n" +
+			"  /catalog/book[2]/year: missing, expected element year"},
+		{"Ignore paths and extra attributes", func(t checkmate.TestingT) bool {
+			return XMLEq(t,
+				`<items><item id="1" at="9"/><item id="2" at="8"/></items>`,
+				`<items><item/><item/></items>`,
+				IgnorePaths("/items/*/@id"), AllowExtraFields(),
+			)
+		}, true, ""},
+		{"Show canonical", func(t checkmate.TestingT) bool {
+			return XMLEq(t,
+				`<p:a xmlns:p="urn:x" b="2" a="1"><c>text &amp; more</c></p:a>`,
+				`<a xmlns="urn:x"/>`, ShowCanonical(),
+			)
+		}, false, "XML documents differ (actual != expected):\n" +
+			"  /a/@a: unexpected \"1\"\n" +
+			"  /a/@b: unexpected \"2\"\n" +
+			"  /a/c: unexpected element c\n" +
+			"canonical actual:\n" +
+			"  <a xmlns=\"urn:x\" a=\"1\" b=\"2\">\n" +
+			"    <c xmlns=\"\">text &amp; more</c>\n" +
+			"  </a>\n" +
+			"canonical expected:\n" +
+			"  <a xmlns=\"urn:x\"/>"},
+		{"Malformed", func(t checkmate.TestingT) bool { return XMLEq(t, `<a><b></a>`, `<a/>`) },
+			false, "cannot decode actual XML: XML syntax error on line 1: element <b> closed by </a>"},
+		{"Two roots", func(t checkmate.TestingT) bool { return XMLEq(t, `<a/>`, `<a/><b/>`) },
+			false, "cannot decode expected XML: more than one root element"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package check
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/eugenetriguba/checkmate"
+	"gopkg.in/yaml.v3"
+)
+
+// YAMLEq checks whether actual and expected are equivalent YAML documents,
+// regardless of mapping key order, formatting, comments, and anchors. Each
+// document may be a []byte, a string, or an io.Reader. Differences are
+// reported by their path, e.g. `$.servers[0].port: 80 != 8080`, with the
+// actual value first. Timestamps are compared as instants, and neither they
+// nor .inf and .nan ever equal a string. DocumentOptions work as they do for
+// JSONEq.
+func YAMLEq(t checkmate.TestingT, actual, expected any, msgAndArgs ...any) bool {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	opts, msgAndArgs := splitOptions[DocumentOption](msgAndArgs)
+
+	actualDoc, err := readYAMLDocument(actual)
+	if err != nil {
+		return check(t, false, "cannot decode actual YAML: %v", err)
+	}
+	expectedDoc, err := readYAMLDocument(expected)
+	if err != nil {
+		return check(t, false, "cannot decode expected YAML: %v", err)
+	}
+
+	config := newDocumentConfig(opts...)
+	differences := config.compare(expectedDoc, actualDoc)
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"YAML documents differ (actual != expected):\n%s%s",
+			formatDifferences(differences, formatJSONPath),
+			config.canonical(canonicalYAML(actualDoc), canonicalYAML(expectedDoc)),
+		}
+	}
+
+	return check(t, len(differences) == 0, msgAndArgs...)
+}
+
+// readYAMLDocument reads and decodes a single YAML document into the same
+// shape as a decoded JSON document, so the two share a comparer. An empty
+// document is null.
+func readYAMLDocument(doc any) (any, error) {
+	data, err := readDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	var value any
+	if err := decoder.Decode(&value); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	var next any
+	if err := decoder.Decode(&next); !errors.Is(err, io.EOF) {
+		return nil, errors.New("expected a single document")
+	}
+
+	return fromYAMLValue(value)
+}
+
+// yamlNonFinite is a YAML float with no JSON equivalent: ".inf", "-.inf", or
+// ".nan". As its own type, it never equals a string such as "+Inf".
+type yamlNonFinite string
+
+// yamlTimestamp is a YAML timestamp, formatted as RFC 3339 in UTC so that
+// timestamps for the same instant are equal. As its own type, it never
+// equals a string.
+type yamlTimestamp string
+
+// fromYAMLValue converts a value decoded by yaml.v3 to its JSON equivalent:
+// mappings become map[string]any and numbers become json.Number. Non-finite
+// floats and timestamps become yamlNonFinite and yamlTimestamp.
+func fromYAMLValue(value any) (any, error) {
+	switch value := value.(type) {
+	case map[string]any:
+		object := make(map[string]any, len(value))
+		for key, child := range value {
+			converted, err := fromYAMLValue(child)
+			if err != nil {
+				return nil, err
+			}
+			object[key] = converted
+		}
+		return object, nil
+	case map[any]any:
+		object := make(map[string]any, len(value))
+		for key, child := range value {
+			converted, err := fromYAMLValue(child)
+			if err != nil {
+				return nil, err
+			}
+			object[fmt.Sprint(key)] = converted
+		}
+		return object, nil
+	case []any:
+		array := make([]any, len(value))
+		for i, child := range value {
+			converted, err := fromYAMLValue(child)
+			if err != nil {
+				return nil, err
+			}
+			array[i] = converted
+		}
+		return array, nil
+	case int:
+		return json.Number(strconv.Itoa(value)), nil
+	case int64:
+		return json.Number(strconv.FormatInt(value, 10)), nil
+	case uint64:
+		return json.Number(strconv.FormatUint(value, 10)), nil
+	case float64:
+		switch {
+		case math.IsInf(value, 1):
+			return yamlNonFinite(".inf"), nil
+		case math.IsInf(value, -1):
+			return yamlNonFinite("-.inf"), nil
+		case math.IsNaN(value):
+			return yamlNonFinite(".nan"), nil
+		}
+		return json.Number(strconv.FormatFloat(value, 'g', -1, 64)), nil
+	case time.Time:
+		return yamlTimestamp(value.UTC().Format(time.RFC3339Nano)), nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(value), nil
+	case string, bool, nil:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported YAML value %v of type %T", value, value)
+	}
+}
+
+// canonicalYAML formats a document as YAML with sorted mapping keys.
+func canonicalYAML(doc any) string {
+	data, err := yaml.Marshal(toYAMLValue(doc))
+	if err != nil {
+		return fmt.Sprintf("%v", doc)
+	}
+	return string(data)
+}
+
+// toYAMLValue converts json.Numbers, yamlNonFinites, and yamlTimestamps back
+// to the Go values yaml.v3 encodes as YAML numbers and timestamps, rather
+// than as strings.
+func toYAMLValue(doc any) any {
+	switch doc := doc.(type) {
+	case map[string]any:
+		object := make(map[string]any, len(doc))
+		for key, child := range doc {
+			object[key] = toYAMLValue(child)
+		}
+		return object
+	case []any:
+		array := make([]any, len(doc))
+		for i, child := range doc {
+			array[i] = toYAMLValue(child)
+		}
+		return array
+	case json.Number:
+		if n, err := doc.Int64(); err == nil {
+			return n
+		}
+		if f, err := doc.Float64(); err == nil {
+			return f
+		}
+		return doc.String()
+	case yamlNonFinite:
+		switch doc {
+		case "-.inf":
+			return math.Inf(-1)
+		case ".nan":
+			return math.NaN()
+		}
+		return math.Inf(1)
+	case yamlTimestamp:
+		if t, err := time.Parse(time.RFC3339Nano, string(doc)); err == nil {
+			return t
+		}
+		return string(doc)
+	default:
+		return doc
+	}
+}
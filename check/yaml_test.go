@@ -0,0 +1,84 @@
+package check
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func TestYAMLEq(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(t checkmate.TestingT) bool
+		shouldPass bool
+		logMessage string
+	}{
+		{"Key order, style, and anchors", func(t checkmate.TestingT) bool {
+			return YAMLEq(t,
+				"defaults: &defaults {port: 80}\nservers:\n  - *defaults\n",
+				strings.NewReader("# config\nservers: [{port: 80}]\ndefaults:\n  port: 80\n"),
+			)
+		}, true, ""},
+		{"Numbers by value", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "ratio: 1.0\ncount: 0x10", []byte("ratio: 1\ncount: 16"))
+		}, true, ""},
+		{"Empty documents", func(t checkmate.TestingT) bool { return YAMLEq(t, "", "# nothing\n") }, true, ""},
+		{"Value differs", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "servers:\n  - port: 80\n", "servers:\n  - port: 8080\n")
+		}, false, "YAML documents differ (actual != expected):\n  $.servers[0].port: 80 != 8080"},
+		{"Quoted number", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, `version: "1"`, `version: 1`)
+		}, false, "YAML documents differ (actual != expected):\n  $.version: \"1\" != 1"},
+		{"Non-finite floats", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "a: .inf\nb: -.Inf\nc: .nan", "a: +.inf\nb: -.inf\nc: .NaN")
+		}, true, ""},
+		{"Infinity is not a string", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "a: .inf", `a: "+Inf"`)
+		}, false, "YAML documents differ (actual != expected):\n  $.a: .inf != \"+Inf\""},
+		{"Timestamps by instant", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "at: 2001-12-14t21:59:43.10-05:00", "at: 2001-12-15T02:59:43.1Z")
+		}, true, ""},
+		{"Timestamp is not a string", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "at: 2001-12-15T02:59:43Z", `at: "2001-12-15T02:59:43Z"`)
+		}, false, "YAML documents differ (actual != expected):\n  $.at: 2001-12-15T02:59:43Z != \"2001-12-15T02:59:43Z\""},
+		{"Show canonical keeps YAML types", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "a: .inf\nat: 2001-12-15T02:59:43Z", "a: -.inf\nat: 2001-12-15T02:59:43Z", ShowCanonical())
+		}, false, "YAML documents differ (actual != expected):\n" +
+			"  $.a: .inf != -.inf\n" +
+			"canonical actual:\n  a: .inf\n  at: 2001-12-15T02:59:43Z\n" +
+			"canonical expected:\n  a: -.inf\n  at: 2001-12-15T02:59:43Z"},
+		{"Ignore paths", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "a: 1\nb: 2", "a: 1\nb: 3", IgnorePaths("/b"))
+		}, true, ""},
+		{"Show canonical", func(t checkmate.TestingT) bool {
+			return YAMLEq(t, "{b: 2, a: [x]}", "a: [y]\nb: 2", ShowCanonical())
+		}, false, "YAML documents differ (actual != expected):\n" +
+			"  $.a[0]: \"x\" != \"y\"\n" +
+			"canonical actual:\n  a:\n      - x\n  b: 2\n" +
+			"canonical expected:\n  a:\n      - \"y\"\n  b: 2"},
+		{"Multiple documents", func(t checkmate.TestingT) bool { return YAMLEq(t, "a: 1\n---\na: 2", "a: 1") },
+			false, "cannot decode actual YAML: expected a single document"},
+		{"Invalid YAML", func(t checkmate.TestingT) bool { return YAMLEq(t, "a: 1", "a: [") },
+			false, "cannot decode expected YAML: yaml: line 1: did not find expected node content"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+
+			passed := tc.fn(mockT)
+
+			if passed != tc.shouldPass || mockT.FailCalled == tc.shouldPass {
+				t.Fatalf(
+					"%s: returned %v with FailCalled = %v, want %v, logs: %v",
+					tc.name, passed, mockT.FailCalled, tc.shouldPass, mockT.Logs,
+				)
+			}
+			if !tc.shouldPass && (len(mockT.Logs) != 1 || mockT.Logs[0] != tc.logMessage) {
+				t.Errorf("%s: expected log message '%s', got %v", tc.name, tc.logMessage, mockT.Logs)
+			}
+		})
+	}
+}
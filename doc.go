@@ -13,6 +13,10 @@
 // Furthermore, any of the assertion of check package will accept a variadic argument at the
 // end called `msgAndArgs`. This allows the caller to pass in their own custom message on test
 // failure along with any arguments for the message if any format placeholders were used.
+//
+// Both packages also offer a fluent style through `That`, e.g.
+// `check.That(t, got).Not().ToBeNil()`. The fluent methods call the same
+// functions underneath, so the failure messages are identical.
 package checkmate
 
 // The subset of testing.T which is used by the
@@ -22,3 +26,9 @@ type TestingT interface {
 	Fail()
 	FailNow()
 }
+
+// The subset of testing.M which is used by the
+// checkmate package.
+type M interface {
+	Run() int
+}
@@ -0,0 +1,250 @@
+// Package httpcheck provides checks for HTTP handlers, built on
+// net/http/httptest. Like the check package, every check marks the test as
+// failed and returns whether it passed, and execution continues. The first
+// failing check on a Response also logs a dump of the request and the
+// response.
+//
+//	resp := httpcheck.Serve(t, handler, httpcheck.NewRequest(http.MethodGet, "/users/1"))
+//	resp.StatusCode(http.StatusOK)
+//	resp.BodyJSONEq(`{"id": 1, "name": "gopher"}`)
+package httpcheck
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"strings"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Response is the recorded response of a handler, with methods to check it.
+type Response struct {
+	t        checkmate.TestingT
+	request  *Request
+	recorder *httptest.ResponseRecorder
+	// err is the error Serve hit while building the request, in which case
+	// nothing was sent and every check returns false.
+	err error
+	// dumped is set once the request and response have been logged, so a
+	// response with several failing checks is only dumped once.
+	dumped bool
+}
+
+// Serve sends the request built by req to handler and records the
+// response. When the request cannot be built, Serve fails without sending
+// it, and every check on the returned Response returns false without
+// reporting anything further.
+func Serve(t checkmate.TestingT, handler http.Handler, req *Request) *Response {
+	if ht, ok := t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if req.err != nil {
+		check.True(t, false, "cannot build request: %v", req.err)
+		return &Response{t: t, recorder: httptest.NewRecorder(), err: req.err}
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req.Build())
+	return &Response{t: t, request: req, recorder: recorder}
+}
+
+// FromRecorder checks a response which was already recorded, such as one
+// written by a handler under test with its own *httptest.ResponseRecorder.
+// The failure dump only includes the response.
+func FromRecorder(t checkmate.TestingT, recorder *httptest.ResponseRecorder) *Response {
+	return &Response{t: t, recorder: recorder}
+}
+
+// Recorder returns the underlying response recorder.
+func (r *Response) Recorder() *httptest.ResponseRecorder {
+	return r.recorder
+}
+
+// Body returns the response body.
+func (r *Response) Body() string {
+	return r.recorder.Body.String()
+}
+
+// StatusCode checks whether the response has the expected status code.
+func (r *Response) StatusCode(expected int, msgAndArgs ...any) bool {
+	if ht, ok := r.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{
+			"expected status %d %s, got %d %s",
+			expected, http.StatusText(expected), r.recorder.Code, http.StatusText(r.recorder.Code),
+		}
+	}
+
+	return r.check(func(t checkmate.TestingT) bool {
+		return check.True(t, r.recorder.Code == expected, msgAndArgs...)
+	})
+}
+
+// Header checks whether the first value of the response header key equals
+// expected.
+func (r *Response) Header(key, expected string, msgAndArgs ...any) bool {
+	if ht, ok := r.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	values, ok := r.recorder.Header()[http.CanonicalHeaderKey(key)]
+	if len(msgAndArgs) == 0 {
+		if ok {
+			msgAndArgs = []any{"expected header %s to be %q, got %q", key, expected, values[0]}
+		} else {
+			msgAndArgs = []any{"expected header %s to be %q, but it is not set", key, expected}
+		}
+	}
+
+	return r.check(func(t checkmate.TestingT) bool {
+		return check.True(t, ok && values[0] == expected, msgAndArgs...)
+	})
+}
+
+// BodyJSONEq checks whether the response body is a JSON document
+// equivalent to expected. See check.JSONEq for the accepted documents and
+// options.
+func (r *Response) BodyJSONEq(expected any, msgAndArgs ...any) bool {
+	if ht, ok := r.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	return r.check(func(t checkmate.TestingT) bool {
+		return check.JSONEq(t, r.recorder.Body.Bytes(), expected, msgAndArgs...)
+	})
+}
+
+// BodyContains checks whether the response body contains substr.
+func (r *Response) BodyContains(substr string, msgAndArgs ...any) bool {
+	if ht, ok := r.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if len(msgAndArgs) == 0 {
+		msgAndArgs = []any{"expected body to contain %q", substr}
+	}
+
+	return r.check(func(t checkmate.TestingT) bool {
+		return check.ContainsString(t, r.recorder.Body.String(), substr, msgAndArgs...)
+	})
+}
+
+// Redirects checks whether the response is a redirect, with a 3xx status
+// code, to location.
+func (r *Response) Redirects(location string, msgAndArgs ...any) bool {
+	if ht, ok := r.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	code := r.recorder.Code
+	actual := r.recorder.Header().Get("Location")
+	isRedirect := code >= 300 && code < 400
+
+	if len(msgAndArgs) == 0 {
+		if isRedirect {
+			msgAndArgs = []any{"expected a redirect to %q, got a redirect to %q", location, actual}
+		} else {
+			msgAndArgs = []any{"expected a redirect to %q, got status %d %s", location, code, http.StatusText(code)}
+		}
+	}
+
+	return r.check(func(t checkmate.TestingT) bool {
+		return check.True(t, isRedirect && actual == location, msgAndArgs...)
+	})
+}
+
+// check runs fn with a deferredT and passes its messages on to r.t. When fn
+// fails, the request and response are dumped before r.t is failed, so the
+// dump stays with its failure inside check.Group.
+func (r *Response) check(fn func(t checkmate.TestingT) bool) bool {
+	if ht, ok := r.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	if r.err != nil {
+		return false
+	}
+
+	deferred := &deferredT{}
+	passed := fn(deferred)
+	for _, log := range deferred.logs {
+		r.t.Log(log)
+	}
+	if deferred.failed {
+		r.dump()
+		r.t.Fail()
+	}
+	return passed
+}
+
+// dump logs the request and response, unless they have been logged already.
+func (r *Response) dump() {
+	if r.dumped {
+		return
+	}
+	r.dumped = true
+
+	var dump strings.Builder
+	if r.request != nil {
+		requestDump, err := httputil.DumpRequest(r.request.Build(), true)
+		if err != nil {
+			fmt.Fprintf(&dump, "request: cannot dump: %v\n", err)
+		} else {
+			fmt.Fprintf(&dump, "request:\n%s\n", indent(string(requestDump)))
+		}
+	}
+
+	responseDump, err := httputil.DumpResponse(r.recorder.Result(), true)
+	if err != nil {
+		fmt.Fprintf(&dump, "response: cannot dump: %v", err)
+	} else {
+		fmt.Fprintf(&dump, "response:\n%s", indent(string(responseDump)))
+	}
+
+	r.t.Log(dump.String())
+}
+
+// deferredT is the checkmate.TestingT given to the checks of a Response. It
+// records their messages and failure instead of reporting them, so that
+// Response.check can report them in order with the dump.
+type deferredT struct {
+	logs   []string
+	failed bool
+}
+
+func (d *deferredT) Log(args ...any) {
+	d.logs = append(d.logs, fmt.Sprint(args...))
+}
+
+func (d *deferredT) Fail() {
+	d.failed = true
+}
+
+// FailNow only records the failure, since the checks it is given to never
+// stop the test.
+func (d *deferredT) FailNow() {
+	d.failed = true
+}
+
+// indent prefixes every non-empty line of s with two spaces and normalizes
+// the CRLF line endings of HTTP dumps.
+func indent(s string) string {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(s, "\r\n", "\n"), "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "  " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+type helperT interface {
+	Helper()
+}
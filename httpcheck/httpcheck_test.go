@@ -0,0 +1,201 @@
+package httpcheck
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+func testHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		var user map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		user["id"] = 1
+		user["auth"] = r.Header.Get("Authorization")
+		user["page"] = r.URL.Query().Get("page")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(user)
+	})
+	mux.HandleFunc("/form", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "hello "+r.FormValue("name"))
+	})
+	mux.Handle("/old", http.RedirectHandler("/new", http.StatusMovedPermanently))
+	return mux
+}
+
+func TestServe(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	resp := Serve(mockT, testHandler(), NewRequest(http.MethodPost, "/users?sort=asc").
+		WithHeader("Authorization", "Bearer token").
+		WithQuery("page", "2").
+		WithJSON(map[string]any{"name": "gopher"}))
+
+	passed := resp.StatusCode(http.StatusCreated) &&
+		resp.Header("content-type", "application/json") &&
+		resp.BodyJSONEq(`{"id": 1, "name": "gopher", "auth": "Bearer token", "page": "2"}`) &&
+		resp.BodyContains(`"name":"gopher"`)
+
+	if !passed || mockT.FailCalled || len(mockT.Logs) != 0 {
+		t.Fatalf("expected every check to pass, logs: %v", mockT.Logs)
+	}
+}
+
+func TestServeForm(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	resp := Serve(mockT, testHandler(), NewRequest(http.MethodPost, "/form").
+		WithForm(url.Values{"name": {"gopher"}}))
+
+	if !resp.BodyContains("hello gopher") || resp.Body() != "hello gopher" {
+		t.Fatalf("expected the form to be sent, logs: %v", mockT.Logs)
+	}
+}
+
+func TestResponseFailures(t *testing.T) {
+	testCases := []struct {
+		name       string
+		fn         func(resp *Response) bool
+		logMessage string
+	}{
+		{"StatusCode", func(resp *Response) bool { return resp.StatusCode(http.StatusOK) },
+			"expected status 200 OK, got 400 Bad Request"},
+		{"Header", func(resp *Response) bool { return resp.Header("Content-Type", "application/json") },
+			`expected header Content-Type to be "application/json", got "text/plain; charset=utf-8"`},
+		{"Header not set", func(resp *Response) bool { return resp.Header("Location", "/") },
+			`expected header Location to be "/", but it is not set`},
+		{"BodyJSONEq", func(resp *Response) bool { return resp.BodyJSONEq(`{}`) },
+			"cannot decode actual JSON: invalid character 'u' looking for beginning of value"},
+		{"BodyContains", func(resp *Response) bool { return resp.BodyContains("created") },
+			`expected body to contain "created"`},
+		{"Redirects", func(resp *Response) bool { return resp.Redirects("/new") },
+			`expected a redirect to "/new", got status 400 Bad Request`},
+		{"Custom message", func(resp *Response) bool { return resp.StatusCode(http.StatusOK, "bad payload") },
+			"bad payload"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockT := &cmtest.MockT{}
+			resp := Serve(mockT, testHandler(), NewRequest(http.MethodPost, "/users").WithBody("fals"))
+
+			if tc.fn(resp) || !mockT.FailCalled {
+				t.Fatalf("expected the check to fail, logs: %v", mockT.Logs)
+			}
+			if len(mockT.Logs) != 2 || mockT.Logs[0] != tc.logMessage {
+				t.Fatalf("expected log message '%s' followed by a dump, got %v", tc.logMessage, mockT.Logs)
+			}
+			wantDump := "request:\n" +
+				"  POST /users HTTP/1.1\n" +
+				"  Host: example.com\n" +
+				"\n" +
+				"  fals\n" +
+				"response:\n" +
+				"  HTTP/1.1 400 Bad Request\n" +
+				"  Connection: close\n" +
+				"  Content-Type: text/plain; charset=utf-8\n" +
+				"  X-Content-Type-Options: nosniff\n" +
+				"\n" +
+				"  unexpected EOF"
+			if mockT.Logs[1] != wantDump {
+				t.Errorf("expected dump:\n%s\ngot:\n%s", wantDump, mockT.Logs[1])
+			}
+		})
+	}
+}
+
+func TestResponseDumpsOnce(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	resp := Serve(mockT, testHandler(), NewRequest(http.MethodGet, "/old"))
+
+	resp.StatusCode(http.StatusOK)
+	resp.Header("Location", "/elsewhere")
+
+	if len(mockT.Logs) != 3 || !strings.HasPrefix(mockT.Logs[1], "request:\n  GET /old HTTP/1.1") {
+		t.Errorf("expected two failures and one dump, got %v", mockT.Logs)
+	}
+	if !resp.Redirects("/new") {
+		t.Errorf("expected a redirect to /new, logs: %v", mockT.Logs)
+	}
+}
+
+func TestFromRecorder(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	recorder := httptest.NewRecorder()
+	http.Redirect(recorder, httptest.NewRequest(http.MethodGet, "/", nil), "/login", http.StatusFound)
+
+	resp := FromRecorder(mockT, recorder)
+
+	if resp.Redirects("/home") || len(mockT.Logs) != 2 {
+		t.Fatalf("expected the check to fail with a dump, logs: %v", mockT.Logs)
+	}
+	if mockT.Logs[0] != `expected a redirect to "/home", got a redirect to "/login"` ||
+		!strings.HasPrefix(mockT.Logs[1], "response:\n  HTTP/1.1 302 Found\n") {
+		t.Errorf("unexpected logs: %v", mockT.Logs)
+	}
+}
+
+func TestServeInvalidJSON(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	resp := Serve(mockT, testHandler(), NewRequest(http.MethodPost, "/users").WithJSON(func() {}))
+
+	if !mockT.FailCalled || len(mockT.Logs) != 1 ||
+		mockT.Logs[0] != "cannot build request: json: unsupported type: func()" {
+		t.Errorf("expected the build error to be reported, logs: %v", mockT.Logs)
+	}
+	if resp.StatusCode(http.StatusOK) || len(mockT.Logs) != 1 {
+		t.Errorf("expected checks to fail without sending the request, logs: %v", mockT.Logs)
+	}
+}
+
+func TestServeInvalidTarget(t *testing.T) {
+	mockT := &cmtest.MockT{}
+	served := false
+	handler := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { served = true })
+
+	resp := Serve(mockT, handler, NewRequest(http.MethodGet, "::bad"))
+
+	if served || !mockT.FailCalled || len(mockT.Logs) != 1 ||
+		mockT.Logs[0] != `cannot build request: parse "::bad": missing protocol scheme` {
+		t.Errorf("expected the invalid target to be reported without serving, logs: %v", mockT.Logs)
+	}
+	if resp.BodyContains("") || len(mockT.Logs) != 1 {
+		t.Errorf("expected checks to fail without reporting again, logs: %v", mockT.Logs)
+	}
+}
+
+func TestResponseInGroup(t *testing.T) {
+	mockT := &cmtest.MockT{}
+
+	check.Group(mockT, "redirect", func(g checkmate.TestingT) {
+		resp := Serve(g, testHandler(), NewRequest(http.MethodGet, "/old"))
+		resp.StatusCode(http.StatusOK)
+		check.True(g, false, "later failure")
+	})
+
+	if len(mockT.Logs) != 1 {
+		t.Fatalf("expected one group report, got %v", mockT.Logs)
+	}
+	report := mockT.Logs[0]
+	wantFirst := "group \"redirect\" had 2 failures:\n" +
+		"  1. expected status 200 OK, got 301 Moved Permanently\n" +
+		"     request:\n" +
+		"       GET /old HTTP/1.1\n"
+	if !strings.HasPrefix(report, wantFirst) || !strings.HasSuffix(report, "\n  2. later failure") {
+		t.Errorf("expected the dump to be reported with the first failure, got:\n%s", report)
+	}
+}
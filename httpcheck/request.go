@@ -0,0 +1,100 @@
+package httpcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+)
+
+// Request builds the *http.Request passed to a handler by Serve.
+//
+//	req := httpcheck.NewRequest(http.MethodPost, "/users").
+//		WithHeader("Authorization", "Bearer token").
+//		WithJSON(map[string]any{"name": "gopher"})
+type Request struct {
+	method string
+	target string
+	header http.Header
+	query  url.Values
+	body   []byte
+	// err is the first error hit while building, reported by Serve.
+	err error
+}
+
+// NewRequest starts building a request for target, which is a path such
+// as "/users?page=2" or an absolute URL. An invalid target is reported by
+// Serve.
+func NewRequest(method, target string) *Request {
+	r := &Request{
+		method: method,
+		target: target,
+		header: http.Header{},
+		query:  url.Values{},
+	}
+	if _, err := url.ParseRequestURI(target); err != nil {
+		r.err = err
+	}
+	return r
+}
+
+// WithHeader adds a header value to the request.
+func (r *Request) WithHeader(key, value string) *Request {
+	r.header.Add(key, value)
+	return r
+}
+
+// WithQuery adds a query parameter to the request's URL, after any which
+// are already in the target.
+func (r *Request) WithQuery(key, value string) *Request {
+	r.query.Add(key, value)
+	return r
+}
+
+// WithBody sets the request body.
+func (r *Request) WithBody(body string) *Request {
+	r.body = []byte(body)
+	return r
+}
+
+// WithJSON sets the request body to v encoded as JSON, along with the
+// Content-Type header.
+func (r *Request) WithJSON(v any) *Request {
+	body, err := json.Marshal(v)
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	r.body = body
+	r.header.Set("Content-Type", "application/json")
+	return r
+}
+
+// WithForm sets the request body to the URL-encoded form values, along with
+// the Content-Type header.
+func (r *Request) WithForm(values url.Values) *Request {
+	r.body = []byte(values.Encode())
+	r.header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+// Build returns a new *http.Request each time it is called, suitable for
+// passing to an http.Handler. Like httptest.NewRequest, it panics when the
+// target is invalid.
+func (r *Request) Build() *http.Request {
+	target := r.target
+	if len(r.query) > 0 {
+		separator := "?"
+		if strings.Contains(target, "?") {
+			separator = "&"
+		}
+		target += separator + r.query.Encode()
+	}
+
+	req := httptest.NewRequest(r.method, target, bytes.NewReader(r.body))
+	for key, values := range r.header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	return req
+}
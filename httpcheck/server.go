@@ -0,0 +1,309 @@
+package httpcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+)
+
+// Server is a fake HTTP server, backed by httptest.Server, which answers
+// the requests a test expects with canned responses. It is the mirror image
+// of Serve, for testing HTTP clients.
+//
+//	server := httpcheck.NewServer(t)
+//	server.Expect(http.MethodGet, "/users/1").RespondJSON(http.StatusOK, user)
+//	client := NewClient(server.URL())
+type Server struct {
+	t      checkmate.TestingT
+	server *httptest.Server
+
+	mu           sync.Mutex
+	expectations []*Expectation
+	unexpected   []string
+}
+
+// NewServer starts a fake server. When t has a Cleanup method, as
+// *testing.T does, the server is closed and verified once the test
+// finishes; otherwise call Close and Verify.
+func NewServer(t checkmate.TestingT) *Server {
+	s := &Server{t: t}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	if ct, ok := t.(cleanupT); ok {
+		ct.Cleanup(func() {
+			s.Close()
+			s.Verify()
+		})
+	}
+	return s
+}
+
+// URL returns the base URL of the server, e.g. "http://127.0.0.1:54321".
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Client returns an HTTP client configured for the server.
+func (s *Server) Client() *http.Client {
+	return s.server.Client()
+}
+
+// Close shuts down the server. It is safe to call more than once.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Expect declares that the server should receive one request with the
+// given method and path, and returns the expectation so it can be refined
+// and given a response. Requests are matched against expectations in the
+// order they were declared.
+func (s *Server) Expect(method, path string) *Expectation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := &Expectation{
+		t:             s.t,
+		method:        method,
+		path:          path,
+		query:         url.Values{},
+		header:        http.Header{},
+		times:         1,
+		status:        http.StatusOK,
+		respondHeader: http.Header{},
+	}
+	s.expectations = append(s.expectations, e)
+	return e
+}
+
+// Verify checks whether every expectation received its requests and no
+// unexpected requests arrived. It is called automatically at the end of the
+// test when the server was created with a t that has a Cleanup method.
+func (s *Server) Verify() bool {
+	if ht, ok := s.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var problems []string
+	for _, e := range s.expectations {
+		if e.calls < e.times {
+			problems = append(problems, fmt.Sprintf(
+				"%s: expected %s, got %s", e, pluralCalls(e.times), pluralCalls(e.calls),
+			))
+		}
+	}
+	for _, request := range s.unexpected {
+		problems = append(problems, "unexpected request "+request)
+	}
+
+	return check.True(
+		s.t, len(problems) == 0,
+		"fake server expectations were not met:\n%s", indent(strings.Join(problems, "\n")),
+	)
+}
+
+// serveHTTP answers a request with the response of the first expectation
+// it matches, or records it as unexpected.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var mismatches []string
+	for _, e := range s.expectations {
+		if e.anyTimes || e.calls < e.times {
+			if matched, reason := e.match(r, body); !matched {
+				if reason != "" {
+					mismatches = append(mismatches, reason)
+				}
+				continue
+			}
+
+			e.calls++
+			for key, values := range e.respondHeader {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(e.status)
+			_, _ = w.Write(e.body)
+			return
+		}
+	}
+
+	description := fmt.Sprintf("%s %s", r.Method, r.URL.RequestURI())
+	if len(body) > 0 {
+		description += fmt.Sprintf(" with body %q", body)
+	}
+	if len(mismatches) > 0 {
+		description += "\n" + indent(strings.Join(mismatches, "\n"))
+	}
+	s.unexpected = append(s.unexpected, description)
+
+	http.Error(w, "httpcheck: unexpected request "+r.Method+" "+r.URL.RequestURI(), http.StatusNotImplemented)
+}
+
+// Expectation is a request the fake server expects to receive, along with
+// the response to send back.
+type Expectation struct {
+	t      checkmate.TestingT
+	method string
+	path   string
+	query  url.Values
+	header http.Header
+	bodies []check.Matcher
+
+	times    int
+	anyTimes bool
+	calls    int
+
+	status        int
+	respondHeader http.Header
+	body          []byte
+}
+
+// WithQuery requires the request to have the query parameter key with
+// value. Other query parameters are allowed.
+func (e *Expectation) WithQuery(key, value string) *Expectation {
+	e.query.Add(key, value)
+	return e
+}
+
+// WithHeader requires the request to have the header key with value.
+func (e *Expectation) WithHeader(key, value string) *Expectation {
+	e.header.Add(key, value)
+	return e
+}
+
+// WithBody requires the request body, passed to m as a string, to match.
+func (e *Expectation) WithBody(m check.Matcher) *Expectation {
+	e.bodies = append(e.bodies, m)
+	return e
+}
+
+// WithJSONBody requires the request body to be a JSON document equivalent
+// to expected. See check.JSONEqualTo.
+func (e *Expectation) WithJSONBody(expected any, opts ...check.DocumentOption) *Expectation {
+	return e.WithBody(check.JSONEqualTo(expected, opts...))
+}
+
+// Times expects the request n times instead of once.
+func (e *Expectation) Times(n int) *Expectation {
+	e.times = n
+	return e
+}
+
+// AnyTimes allows the request any number of times, including none.
+func (e *Expectation) AnyTimes() *Expectation {
+	e.times = 0
+	e.anyTimes = true
+	return e
+}
+
+// Respond sets the status code and body of the response. Without it, the
+// server responds with 200 OK and an empty body.
+func (e *Expectation) Respond(status int, body string) *Expectation {
+	e.status = status
+	e.body = []byte(body)
+	return e
+}
+
+// RespondJSON sets the status code of the response and its body to v
+// encoded as JSON, along with the Content-Type header.
+func (e *Expectation) RespondJSON(status int, v any) *Expectation {
+	if ht, ok := e.t.(helperT); ok {
+		ht.Helper()
+	}
+
+	body, err := json.Marshal(v)
+	if err != nil {
+		check.True(e.t, false, "cannot encode the response to %s as JSON: %v", e, err)
+	}
+	e.status = status
+	e.body = body
+	e.respondHeader.Set("Content-Type", "application/json")
+	return e
+}
+
+// RespondHeader adds a header to the response.
+func (e *Expectation) RespondHeader(key, value string) *Expectation {
+	e.respondHeader.Add(key, value)
+	return e
+}
+
+// String describes the expected request, e.g. "GET /users?page=2".
+func (e *Expectation) String() string {
+	if len(e.query) == 0 {
+		return e.method + " " + e.path
+	}
+	return e.method + " " + e.path + "?" + e.query.Encode()
+}
+
+// match reports whether the request matches the expectation and, if not,
+// why. Requests for another method or path are not explained, since they
+// are clearly meant for another expectation.
+func (e *Expectation) match(r *http.Request, body []byte) (bool, string) {
+	if r.Method != e.method || r.URL.Path != e.path {
+		return false, ""
+	}
+
+	query := r.URL.Query()
+	for _, key := range sortedKeys(e.query) {
+		for _, value := range e.query[key] {
+			if !slices.Contains(query[key], value) {
+				return false, fmt.Sprintf("%s: query parameter %s is %q, want %q", e, key, query[key], value)
+			}
+		}
+	}
+	for _, key := range sortedKeys(e.header) {
+		for _, value := range e.header[key] {
+			if !slices.Contains(r.Header.Values(key), value) {
+				return false, fmt.Sprintf("%s: header %s is %q, want %q", e, key, r.Header.Values(key), value)
+			}
+		}
+	}
+	for _, m := range e.bodies {
+		if matched, description := m.Match(string(body)); !matched {
+			return false, fmt.Sprintf("%s: body did not match:\n%s", e, indent(description))
+		}
+	}
+	return true, ""
+}
+
+// pluralCalls formats a number of calls, e.g. "1 call" or "2 calls".
+func pluralCalls(n int) string {
+	if n == 1 {
+		return "1 call"
+	}
+	return fmt.Sprintf("%d calls", n)
+}
+
+// sortedKeys returns the keys of query parameters or headers in sorted
+// order.
+func sortedKeys[M ~map[string][]string](values M) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type cleanupT interface {
+	Cleanup(func())
+}
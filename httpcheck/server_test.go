@@ -0,0 +1,134 @@
+package httpcheck
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/eugenetriguba/checkmate"
+	"github.com/eugenetriguba/checkmate/check"
+	"github.com/eugenetriguba/checkmate/internal/cmtest"
+)
+
+// send makes a request to the fake server and returns the response status
+// and body.
+func send(t *testing.T, server *Server, method, target, body string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, server.URL()+target, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(respBody)
+}
+
+func TestServerMeetsExpectations(t *testing.T) {
+	mockT := &cmtest.MockTB{}
+	server := NewServer(mockT)
+	server.Expect(http.MethodGet, "/users").WithQuery("page", "2").
+		RespondJSON(http.StatusOK, []string{"gopher"})
+	server.Expect(http.MethodPost, "/users").WithJSONBody(`{"name": "gopher"}`).
+		Respond(http.StatusCreated, "created").RespondHeader("Location", "/users/1")
+	server.Expect(http.MethodGet, "/health").AnyTimes()
+	server.Expect(http.MethodDelete, "/users/1").Times(2)
+
+	if status, body := send(t, server, http.MethodGet, "/users?page=2&sort=asc", ""); status != http.StatusOK ||
+		body != `["gopher"]` {
+		t.Errorf("unexpected response %d %q", status, body)
+	}
+	if status, body := send(t, server, http.MethodPost, "/users", `{ "name":"gopher" }`); status != http.StatusCreated ||
+		body != "created" {
+		t.Errorf("unexpected response %d %q", status, body)
+	}
+	send(t, server, http.MethodDelete, "/users/1", "")
+	send(t, server, http.MethodDelete, "/users/1", "")
+
+	mockT.RunCleanups()
+
+	if mockT.FailCalled || len(mockT.Logs) != 0 {
+		t.Errorf("expected the expectations to be met, logs: %v", mockT.Logs)
+	}
+}
+
+func TestServerReportsUnmetExpectations(t *testing.T) {
+	mockT := &cmtest.MockTB{}
+	server := NewServer(mockT)
+	server.Expect(http.MethodPost, "/users").WithHeader("Authorization", "Bearer token").
+		WithBody(check.MatcherFunc(func(actual any) (bool, string) {
+			return strings.Contains(actual.(string), "gopher"), "body mentions gopher"
+		}))
+	server.Expect(http.MethodGet, "/users").WithQuery("page", "1").Times(2)
+
+	status, body := send(t, server, http.MethodPost, "/users", "rustacean")
+	if status != http.StatusNotImplemented || body != "httpcheck: unexpected request POST /users\n" {
+		t.Errorf("unexpected response %d %q", status, body)
+	}
+	send(t, server, http.MethodGet, "/users?page=1", "")
+	send(t, server, http.MethodGet, "/users?page=3", "")
+
+	mockT.RunCleanups()
+
+	want := "fake server expectations were not met:\n" +
+		"  POST /users: expected 1 call, got 0 calls\n" +
+		"  GET /users?page=1: expected 2 calls, got 1 call\n" +
+		"  unexpected request POST /users with body \"rustacean\"\n" +
+		"    POST /users: header Authorization is [], want \"Bearer token\"\n" +
+		"  unexpected request GET /users?page=3\n" +
+		"    GET /users?page=1: query parameter page is [\"3\"], want \"1\""
+	if !mockT.FailCalled || len(mockT.Logs) != 1 || mockT.Logs[0] != want {
+		t.Errorf("expected log message:\n%s\ngot: %v", want, mockT.Logs)
+	}
+}
+
+func TestServerBodyMismatch(t *testing.T) {
+	mockT := &cmtest.MockTB{}
+	server := NewServer(mockT)
+	server.Expect(http.MethodPut, "/users/1").WithJSONBody(`{"name": "gopher"}`)
+
+	send(t, server, http.MethodPut, "/users/1", `{"name": "ferris"}`)
+	server.Close()
+
+	if server.Verify() {
+		t.Fatal("expected Verify to fail")
+	}
+	want := "fake server expectations were not met:\n" +
+		"  PUT /users/1: expected 1 call, got 0 calls\n" +
+		"  unexpected request PUT /users/1 with body \"{\\\"name\\\": \\\"ferris\\\"}\"\n" +
+		"    PUT /users/1: body did not match:\n" +
+		"      JSON documents differ (actual != expected):\n" +
+		"        /name: \"ferris\" != \"gopher\""
+	if len(mockT.Logs) != 1 || mockT.Logs[0] != want {
+		t.Errorf("expected log message:\n%s\ngot: %v", want, mockT.Logs)
+	}
+}
+
+func TestServerInGroup(t *testing.T) {
+	mockT := &cmtest.MockTB{}
+
+	passed := check.Group(mockT, "client", func(g checkmate.TestingT) {
+		server := NewServer(g)
+		server.Expect(http.MethodGet, "/users")
+	})
+
+	if !passed || mockT.FailCalled {
+		t.Fatalf("expected the group to pass before the test finishes, logs: %v", mockT.Logs)
+	}
+
+	mockT.RunCleanups()
+
+	expected := "fake server expectations were not met:\n  GET /users: expected 1 call, got 0 calls"
+	if !mockT.FailCalled || len(mockT.Logs) != 1 || mockT.Logs[0] != expected {
+		t.Errorf("expected the unmet expectation to fail the test, logs: %v", mockT.Logs)
+	}
+}
@@ -37,3 +37,30 @@ type MockHelperT struct {
 func (m *MockHelperT) Helper() {
 	m.HelperCalled = true
 }
+
+// MockTB has a MockT and also provides the Name and Cleanup methods
+// of testing.TB.
+type MockTB struct {
+	MockT
+
+	TestName string
+	cleanups []func()
+}
+
+func (m *MockTB) Name() string {
+	return m.TestName
+}
+
+func (m *MockTB) Cleanup(fn func()) {
+	m.cleanups = append(m.cleanups, fn)
+}
+
+// RunCleanups calls the registered cleanup functions in last added, first
+// called order, like the testing package does once a test finishes.
+func (m *MockTB) RunCleanups() {
+	for len(m.cleanups) > 0 {
+		fn := m.cleanups[len(m.cleanups)-1]
+		m.cleanups = m.cleanups[:len(m.cleanups)-1]
+		fn()
+	}
+}
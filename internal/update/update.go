@@ -0,0 +1,29 @@
+// Package update holds the switch which tells golden files and snapshots to
+// be rewritten with the actual values instead of being compared.
+package update
+
+import (
+	"flag"
+	"os"
+	"strconv"
+)
+
+// EnvVar enables update mode when set to a true value, e.g.
+// CHECKMATE_UPDATE=1, for environments where passing test flags is awkward.
+const EnvVar = "CHECKMATE_UPDATE"
+
+var updateFlag = flag.Bool(
+	"checkmate.update", false,
+	"rewrite golden files and snapshots with the actual values",
+)
+
+// Enabled reports whether the -checkmate.update flag or the EnvVar
+// environment variable is set.
+func Enabled() bool {
+	if *updateFlag {
+		return true
+	}
+
+	enabled, _ := strconv.ParseBool(os.Getenv(EnvVar))
+	return enabled
+}